@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Command fakebdcc serves an in-memory fake of the Bob's Discount Cloud
+// Company API, for running the provider without network access:
+//
+//	go run ./cmd/fakebdcc -listen 127.0.0.1:8080 -api-key test
+//	HASHICUPS_HOST=http://127.0.0.1:8080 HASHICUPS_API_KEY=test terraform apply
+package main
+
+import (
+	"flag"
+	"log"
+	"net/http"
+	"time"
+
+	"terraform-provider-hashicups/internal/fakebdcc"
+)
+
+func main() {
+	var (
+		listen  string
+		options fakebdcc.Options
+	)
+
+	flag.StringVar(&listen, "listen", "127.0.0.1:8080", "address to listen on")
+	flag.StringVar(&options.APIKey, "api-key", "", "API key clients must send; empty accepts any key")
+	flag.DurationVar(&options.Latency, "latency", 0, "delay added to every response")
+	flag.DurationVar(&options.Jitter, "jitter", 0, "maximum random delay added on top of -latency")
+	flag.Float64Var(&options.FailureRate, "failure-rate", 0, "probability between 0 and 1 that a request fails with 503")
+	flag.Int64Var(&options.Seed, "seed", time.Now().UnixNano(), "seed for jitter and failures")
+	flag.DurationVar(&options.ProvisioningDelay, "provisioning-delay", 0, "how long clones, snapshots and restores take to complete")
+	flag.Parse()
+
+	server := fakebdcc.New(options)
+
+	log.Printf("fake Bob's API listening on http://%s", listen)
+	log.Fatal(http.ListenAndServe(listen, logRequests(server)))
+}
+
+// logRequests logs the method and path of every request.
+func logRequests(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		log.Printf("%s %s", r.Method, r.URL.RequestURI())
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,10 @@
+action "bobsdiscountcloudco_copy_items_action" "seed_staging" {
+  config {
+    source_database_id = bobsdiscountcloudco_database.production.id
+    target_database_id = bobsdiscountcloudco_database.staging.id
+    key_prefix         = "users/"
+    target_key_prefix  = "seed/users/"
+    conflict_policy    = "skip"
+    dry_run            = true
+  }
+}
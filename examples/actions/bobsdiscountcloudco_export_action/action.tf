@@ -0,0 +1,8 @@
+action "bobsdiscountcloudco_export_action" "audit" {
+  config {
+    database_id = bobsdiscountcloudco_database.example.id
+    path        = "${path.module}/exports/orders.ndjson"
+    format      = "ndjson"
+    key_prefix  = "orders/"
+  }
+}
@@ -0,0 +1,23 @@
+data "bobsdiscountcloudco_database_snapshots" "production" {
+  database_id = bobsdiscountcloudco_database.production.id
+}
+
+action "bobsdiscountcloudco_restore_action" "refresh_staging" {
+  config {
+    database_id        = bobsdiscountcloudco_database.production.id
+    snapshot_id        = data.bobsdiscountcloudco_database_snapshots.production.most_recent.id
+    target_database_id = bobsdiscountcloudco_database.staging.id
+  }
+}
+
+# Refresh staging whenever a newer production snapshot becomes available.
+resource "terraform_data" "staging_snapshot" {
+  input = data.bobsdiscountcloudco_database_snapshots.production.most_recent.id
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create, after_update]
+      actions = [action.bobsdiscountcloudco_restore_action.refresh_staging]
+    }
+  }
+}
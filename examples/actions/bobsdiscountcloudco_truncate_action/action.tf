@@ -0,0 +1,7 @@
+action "bobsdiscountcloudco_truncate_action" "reset_sessions" {
+  config {
+    database_id = bobsdiscountcloudco_database.test.id
+    key_prefix  = "sessions/"
+    confirm     = bobsdiscountcloudco_database.test.name
+  }
+}
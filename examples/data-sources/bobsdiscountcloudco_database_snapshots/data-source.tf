@@ -0,0 +1,10 @@
+data "bobsdiscountcloudco_database_snapshots" "production" {
+  database_id = bobsdiscountcloudco_database.production.id
+}
+
+# Start staging from the latest available snapshot of production.
+resource "bobsdiscountcloudco_database" "staging" {
+  name               = "orders-staging"
+  source_database_id = bobsdiscountcloudco_database.production.id
+  source_snapshot_id = data.bobsdiscountcloudco_database_snapshots.production.most_recent.id
+}
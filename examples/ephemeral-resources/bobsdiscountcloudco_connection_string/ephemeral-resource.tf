@@ -0,0 +1,4 @@
+ephemeral "bobsdiscountcloudco_connection_string" "app" {
+  database_id = bobsdiscountcloudco_database.example.id
+  scope       = "write"
+}
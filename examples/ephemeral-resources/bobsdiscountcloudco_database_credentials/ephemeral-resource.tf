@@ -0,0 +1,5 @@
+ephemeral "bobsdiscountcloudco_database_credentials" "app" {
+  database_id = bobsdiscountcloudco_database.example.id
+  scope       = "read"
+  ttl_seconds = 3600
+}
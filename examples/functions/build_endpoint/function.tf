@@ -0,0 +1,3 @@
+output "eu_west_1_endpoint" {
+  value = provider::bobsdiscountcloudco::build_endpoint("eu-west-1")
+}
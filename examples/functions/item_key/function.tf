@@ -0,0 +1,3 @@
+output "feature_flags_key" {
+  value = provider::bobsdiscountcloudco::item_key("config", "feature-flags", "v1")
+}
@@ -0,0 +1,9 @@
+# Fully qualified IDs are split into all of their components.
+output "database_region" {
+  value = provider::bobsdiscountcloudco::parse_database_id("bdcc:us-east-1:123456789012:db-0123abcd").region
+}
+
+# Plain IDs, as returned by the provider, have a null region and account_id.
+output "database_id" {
+  value = provider::bobsdiscountcloudco::parse_database_id(bobsdiscountcloudco_database.example.id).database_id
+}
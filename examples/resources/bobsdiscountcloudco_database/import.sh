@@ -0,0 +1,2 @@
+# Databases can be imported by specifying their ID.
+terraform import bobsdiscountcloudco_database.example db-0123abcd
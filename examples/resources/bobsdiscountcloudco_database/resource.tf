@@ -0,0 +1,16 @@
+resource "bobsdiscountcloudco_database" "example" {
+  name       = "orders"
+  tier       = "premium"
+  storage_gb = 250
+
+  tags = {
+    environment = "production"
+  }
+}
+
+# A clone of the database, for testing against production data.
+resource "bobsdiscountcloudco_database" "staging" {
+  name                = "orders-staging"
+  deletion_protection = false
+  source_database_id  = bobsdiscountcloudco_database.example.id
+}
@@ -0,0 +1,2 @@
+# Items can be imported by specifying <database_id>/<key>.
+terraform import bobsdiscountcloudco_database_item.feature_flags db-0123abcd/config/feature-flags
@@ -0,0 +1,5 @@
+resource "bobsdiscountcloudco_database_item" "feature_flags" {
+  database_id = bobsdiscountcloudco_database.example.id
+  key         = provider::bobsdiscountcloudco::item_key("config", "feature-flags")
+  value       = jsonencode({ new_checkout = true })
+}
@@ -0,0 +1,2 @@
+# Snapshots can be imported by specifying <database_id>/<snapshot_id>.
+terraform import bobsdiscountcloudco_database_snapshot.nightly db-0123abcd/snap-0123abcd
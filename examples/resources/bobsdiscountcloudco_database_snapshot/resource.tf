@@ -0,0 +1,5 @@
+resource "bobsdiscountcloudco_database_snapshot" "nightly" {
+  database_id    = bobsdiscountcloudco_database.example.id
+  description    = "Before the schema migration"
+  retention_days = 30
+}
@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakebdcc
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+)
+
+// defaultCredentialTTL is used when a credential is created without a TTL.
+const defaultCredentialTTL = time.Hour
+
+var credentialScopes = map[string]bool{"read": true, "write": true, "admin": true}
+
+// Credential is the API representation of a database credential.
+type Credential struct {
+	Id         string `json:"id"`
+	DatabaseId string `json:"database_id"`
+	Scope      string `json:"scope"`
+	Key        string `json:"key"`
+	ExpiresAt  string `json:"expires_at"`
+}
+
+type createCredentialRequest struct {
+	Scope      string `json:"scope"`
+	TTLSeconds int64  `json:"ttl_seconds"`
+}
+
+// credential is a stored credential and the TTL it is renewed for.
+type credential struct {
+	Credential
+	ttl time.Duration
+}
+
+// lookupCredential returns the credential of db named by the credential_id
+// path value, failing the request with 404 if it does not exist. Callers
+// must hold s.mu.
+func (s *Server) lookupCredential(w http.ResponseWriter, r *http.Request, db *database) (*credential, bool) {
+	cred, ok := s.credentials[r.PathValue("credential_id")]
+	if !ok || cred.DatabaseId != db.Id {
+		writeError(w, http.StatusNotFound, "credential "+r.PathValue("credential_id")+" not found")
+		return nil, false
+	}
+
+	return cred, true
+}
+
+func (s *Server) listCredentials(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	db, ok := s.lookupDatabase(w, r)
+	if !ok {
+		return
+	}
+
+	var ids []string
+	for id, cred := range s.credentials {
+		if cred.DatabaseId == db.Id {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+
+	// Keys are only returned when a credential is created or renewed.
+	credentials := make([]Credential, 0, len(ids))
+	for _, id := range ids {
+		cred := s.credentials[id].Credential
+		cred.Key = ""
+		credentials = append(credentials, cred)
+	}
+
+	writeJSON(w, map[string]any{"credentials": credentials})
+}
+
+func (s *Server) createCredential(w http.ResponseWriter, r *http.Request) {
+	var req createCredentialRequest
+	if !decode(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	db, ok := s.lookupDatabase(w, r)
+	if !ok {
+		return
+	}
+	if !credentialScopes[req.Scope] {
+		writeError(w, http.StatusBadRequest, "scope must be one of read, write or admin")
+		return
+	}
+	if req.TTLSeconds < 0 {
+		writeError(w, http.StatusBadRequest, "ttl_seconds must not be negative")
+		return
+	}
+
+	ttl := defaultCredentialTTL
+	if req.TTLSeconds > 0 {
+		ttl = time.Duration(req.TTLSeconds) * time.Second
+	}
+
+	cred := &credential{
+		Credential: Credential{
+			Id:         s.newID("cred"),
+			DatabaseId: db.Id,
+			Scope:      req.Scope,
+			Key:        fmt.Sprintf("%016x%016x", s.rand.Uint64(), s.rand.Uint64()),
+			ExpiresAt:  time.Now().Add(ttl).UTC().Format(time.RFC3339),
+		},
+		ttl: ttl,
+	}
+	s.credentials[cred.Id] = cred
+
+	writeJSON(w, cred.Credential)
+}
+
+func (s *Server) renewCredential(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	db, ok := s.lookupDatabase(w, r)
+	if !ok {
+		return
+	}
+	cred, ok := s.lookupCredential(w, r, db)
+	if !ok {
+		return
+	}
+
+	cred.ExpiresAt = time.Now().Add(cred.ttl).UTC().Format(time.RFC3339)
+
+	writeJSON(w, cred.Credential)
+}
+
+func (s *Server) revokeCredential(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	db, ok := s.lookupDatabase(w, r)
+	if !ok {
+		return
+	}
+	cred, ok := s.lookupCredential(w, r, db)
+	if !ok {
+		return
+	}
+
+	delete(s.credentials, cred.Id)
+
+	writeJSON(w, map[string]any{})
+}
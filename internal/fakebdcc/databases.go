@@ -0,0 +1,544 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakebdcc
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	statusCreating  = "creating"
+	statusAvailable = "available"
+
+	// defaultTier and defaultEngineVersion are used when a database is
+	// created without them.
+	defaultTier          = "standard"
+	defaultEngineVersion = "2.1"
+
+	// defaultPageSize and maxPageSize bound the pages of list endpoints.
+	defaultPageSize = 100
+	maxPageSize     = 1000
+)
+
+// tier is the default and maximum capacity of a database tier.
+type tier struct {
+	DefaultStorageGB int64
+	MaxStorageGB     int64
+	MaxItems         int64
+}
+
+var tiers = map[string]tier{
+	"free":     {DefaultStorageGB: 1, MaxStorageGB: 1, MaxItems: 1000},
+	"standard": {DefaultStorageGB: 10, MaxStorageGB: 100, MaxItems: 1000000},
+	"premium":  {DefaultStorageGB: 100, MaxStorageGB: 1000, MaxItems: 100000000},
+}
+
+// Database is the API representation of a database.
+type Database struct {
+	Id                 string            `json:"id"`
+	Name               string            `json:"name"`
+	DeletionProtection bool              `json:"deletion_protection"`
+	Tags               map[string]string `json:"tags,omitempty"`
+	Tier               string            `json:"tier"`
+	StorageGB          int64             `json:"storage_gb"`
+	MaxItems           int64             `json:"max_items"`
+	EngineVersion      string            `json:"engine_version"`
+	CreatedAt          string            `json:"created_at"`
+	UpdatedAt          string            `json:"updated_at"`
+	Status             string            `json:"status"`
+	SourceDatabaseId   string            `json:"source_database_id,omitempty"`
+	SourceSnapshotId   string            `json:"source_snapshot_id,omitempty"`
+}
+
+// Item is the API representation of a database item.
+type Item struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+type createDatabaseRequest struct {
+	Name               string            `json:"name"`
+	DeletionProtection bool              `json:"deletion_protection"`
+	Tags               map[string]string `json:"tags"`
+	Tier               string            `json:"tier"`
+	StorageGB          int64             `json:"storage_gb"`
+	MaxItems           int64             `json:"max_items"`
+	EngineVersion      string            `json:"engine_version"`
+	SnapshotId         string            `json:"snapshot_id"`
+}
+
+type updateDatabaseRequest struct {
+	Name               string            `json:"name"`
+	DeletionProtection bool              `json:"deletion_protection"`
+	Tags               map[string]string `json:"tags"`
+	Tier               string            `json:"tier"`
+	StorageGB          int64             `json:"storage_gb"`
+	MaxItems           int64             `json:"max_items"`
+}
+
+type deleteItemsRequest struct {
+	Keys []string `json:"keys"`
+}
+
+// database is a stored database. started is when it began provisioning.
+type database struct {
+	Database
+	started time.Time
+	items   map[string]string
+}
+
+// Items returns a copy of the items of a database, or nil if it does not
+// exist.
+func (s *Server) Items(databaseID string) map[string]string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	db, ok := s.databases[databaseID]
+	if !ok {
+		return nil
+	}
+
+	return copyItems(db.items)
+}
+
+// RemoveDatabase deletes a database and everything that belongs to it,
+// ignoring deletion protection, as if it was deleted outside of Terraform.
+// It reports whether the database existed.
+func (s *Server) RemoveDatabase(databaseID string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.databases[databaseID]; !ok {
+		return false
+	}
+
+	s.removeDatabase(databaseID)
+	return true
+}
+
+// removeDatabase deletes a database with its snapshots, restores and
+// credentials. Callers must hold s.mu.
+func (s *Server) removeDatabase(databaseID string) {
+	delete(s.databases, databaseID)
+
+	for id, snap := range s.snapshots {
+		if snap.DatabaseId == databaseID {
+			delete(s.snapshots, id)
+		}
+	}
+	for id, rest := range s.restores {
+		if rest.DatabaseId == databaseID {
+			delete(s.restores, id)
+		}
+	}
+	for id, cred := range s.credentials {
+		if cred.DatabaseId == databaseID {
+			delete(s.credentials, id)
+		}
+	}
+}
+
+// view returns the API representation of db. Callers must hold s.mu.
+func (s *Server) view(db *database) Database {
+	view := db.Database
+	view.Status = statusAvailable
+	if ready, _ := s.ready(db.started); !ready {
+		view.Status = statusCreating
+	}
+
+	return view
+}
+
+// lookupDatabase returns the database named by the id path value, failing
+// the request with 404 if it does not exist. Callers must hold s.mu.
+func (s *Server) lookupDatabase(w http.ResponseWriter, r *http.Request) (*database, bool) {
+	db, ok := s.databases[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "database "+r.PathValue("id")+" not found")
+		return nil, false
+	}
+
+	return db, true
+}
+
+// newDatabase validates req and stores a new database holding items.
+// Callers must hold s.mu.
+func (s *Server) newDatabase(w http.ResponseWriter, req createDatabaseRequest, items map[string]string, started time.Time) (*database, bool) {
+	if req.Name == "" {
+		writeError(w, http.StatusBadRequest, "name is required")
+		return nil, false
+	}
+
+	if req.Tier == "" {
+		req.Tier = defaultTier
+	}
+	limits, ok := tiers[req.Tier]
+	if !ok {
+		writeError(w, http.StatusBadRequest, "unknown tier "+req.Tier)
+		return nil, false
+	}
+	if req.StorageGB == 0 {
+		req.StorageGB = limits.DefaultStorageGB
+	}
+	if req.MaxItems == 0 {
+		req.MaxItems = limits.MaxItems
+	}
+	if req.StorageGB > limits.MaxStorageGB || req.MaxItems > limits.MaxItems {
+		writeError(w, http.StatusBadRequest, "capacity exceeds the limits of the "+req.Tier+" tier")
+		return nil, false
+	}
+	if req.EngineVersion == "" {
+		req.EngineVersion = defaultEngineVersion
+	}
+
+	timestamp := now()
+	db := &database{
+		Database: Database{
+			Id:                 s.newID("db"),
+			Name:               req.Name,
+			DeletionProtection: req.DeletionProtection,
+			Tags:               req.Tags,
+			Tier:               req.Tier,
+			StorageGB:          req.StorageGB,
+			MaxItems:           req.MaxItems,
+			EngineVersion:      req.EngineVersion,
+			CreatedAt:          timestamp,
+			UpdatedAt:          timestamp,
+		},
+		started: started,
+		items:   items,
+	}
+	s.databases[db.Id] = db
+
+	return db, true
+}
+
+func (s *Server) listDatabases(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var ids []string
+	for id, db := range s.databases {
+		if strings.HasPrefix(db.Name, r.URL.Query().Get("name_prefix")) {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+
+	page, next, ok := paginate(w, r, ids)
+	if !ok {
+		return
+	}
+
+	databases := make([]Database, 0, len(page))
+	for _, id := range page {
+		databases = append(databases, s.view(s.databases[id]))
+	}
+
+	writeJSON(w, map[string]any{"databases": databases, "next_token": next})
+}
+
+func (s *Server) createDatabase(w http.ResponseWriter, r *http.Request) {
+	var req createDatabaseRequest
+	if !decode(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// New empty databases need no provisioning.
+	db, ok := s.newDatabase(w, req, map[string]string{}, time.Time{})
+	if !ok {
+		return
+	}
+
+	writeJSON(w, s.view(db))
+}
+
+func (s *Server) cloneDatabase(w http.ResponseWriter, r *http.Request) {
+	var req createDatabaseRequest
+	if !decode(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	source, ok := s.lookupDatabase(w, r)
+	if !ok {
+		return
+	}
+	if s.view(source).Status != statusAvailable {
+		writeError(w, http.StatusConflict, "database "+source.Id+" is not available")
+		return
+	}
+
+	items := source.items
+	if req.SnapshotId != "" {
+		snap, ok := s.snapshots[req.SnapshotId]
+		if !ok || snap.DatabaseId != source.Id {
+			writeError(w, http.StatusNotFound, "snapshot "+req.SnapshotId+" of database "+source.Id+" not found")
+			return
+		}
+		if s.snapshotView(snap).Status != statusAvailable {
+			writeError(w, http.StatusConflict, "snapshot "+snap.Id+" is not available")
+			return
+		}
+		items = snap.items
+	}
+
+	db, ok := s.newDatabase(w, req, copyItems(items), time.Now())
+	if !ok {
+		return
+	}
+	db.SourceDatabaseId = source.Id
+	db.SourceSnapshotId = req.SnapshotId
+
+	writeJSON(w, s.view(db))
+}
+
+func (s *Server) getDatabase(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	db, ok := s.lookupDatabase(w, r)
+	if !ok {
+		return
+	}
+
+	writeJSON(w, s.view(db))
+}
+
+func (s *Server) updateDatabase(w http.ResponseWriter, r *http.Request) {
+	var req updateDatabaseRequest
+	if !decode(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	db, ok := s.lookupDatabase(w, r)
+	if !ok {
+		return
+	}
+
+	tierName, storageGB, maxItems := db.Tier, db.StorageGB, db.MaxItems
+	if req.Tier != "" {
+		tierName = req.Tier
+	}
+	if req.StorageGB != 0 {
+		storageGB = req.StorageGB
+	}
+	if req.MaxItems != 0 {
+		maxItems = req.MaxItems
+	}
+
+	limits, ok := tiers[tierName]
+	if !ok {
+		writeError(w, http.StatusBadRequest, "unknown tier "+tierName)
+		return
+	}
+	if storageGB < db.StorageGB {
+		writeError(w, http.StatusBadRequest, "storage_gb cannot be decreased")
+		return
+	}
+	if storageGB > limits.MaxStorageGB || maxItems > limits.MaxItems {
+		writeError(w, http.StatusBadRequest, "capacity exceeds the limits of the "+tierName+" tier")
+		return
+	}
+
+	if req.Name != "" {
+		db.Name = req.Name
+	}
+	db.DeletionProtection = req.DeletionProtection
+	db.Tags = req.Tags
+	db.Tier, db.StorageGB, db.MaxItems = tierName, storageGB, maxItems
+	db.UpdatedAt = now()
+
+	writeJSON(w, s.view(db))
+}
+
+func (s *Server) deleteDatabase(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	db, ok := s.lookupDatabase(w, r)
+	if !ok {
+		return
+	}
+	if db.DeletionProtection {
+		writeError(w, http.StatusConflict, "database "+db.Id+" has deletion protection enabled")
+		return
+	}
+
+	s.removeDatabase(db.Id)
+
+	writeJSON(w, map[string]any{})
+}
+
+func (s *Server) listItems(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	db, ok := s.lookupDatabase(w, r)
+	if !ok {
+		return
+	}
+
+	var keys []string
+	for key := range db.items {
+		if strings.HasPrefix(key, r.URL.Query().Get("prefix")) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	page, next, ok := paginate(w, r, keys)
+	if !ok {
+		return
+	}
+
+	items := make([]Item, 0, len(page))
+	for _, key := range page {
+		items = append(items, Item{Key: key, Value: db.items[key]})
+	}
+
+	writeJSON(w, map[string]any{"items": items, "next_token": next})
+}
+
+func (s *Server) putItem(w http.ResponseWriter, r *http.Request) {
+	var req Item
+	if !decode(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	db, ok := s.lookupDatabase(w, r)
+	if !ok {
+		return
+	}
+	if req.Key == "" {
+		writeError(w, http.StatusBadRequest, "key is required")
+		return
+	}
+	if _, exists := db.items[req.Key]; !exists && int64(len(db.items)) >= db.MaxItems {
+		writeError(w, http.StatusConflict, "database "+db.Id+" already holds max_items items")
+		return
+	}
+
+	db.items[req.Key] = req.Value
+
+	writeJSON(w, []Item{req})
+}
+
+func (s *Server) getItem(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	db, ok := s.lookupDatabase(w, r)
+	if !ok {
+		return
+	}
+
+	key := r.PathValue("key")
+	value, ok := db.items[key]
+	if !ok {
+		writeError(w, http.StatusNotFound, "item "+key+" not found")
+		return
+	}
+
+	writeJSON(w, Item{Key: key, Value: value})
+}
+
+func (s *Server) deleteItem(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	db, ok := s.lookupDatabase(w, r)
+	if !ok {
+		return
+	}
+
+	key := r.PathValue("key")
+	if _, ok := db.items[key]; !ok {
+		writeError(w, http.StatusNotFound, "item "+key+" not found")
+		return
+	}
+	delete(db.items, key)
+
+	writeJSON(w, map[string]any{})
+}
+
+func (s *Server) deleteItems(w http.ResponseWriter, r *http.Request) {
+	var req deleteItemsRequest
+	if !decode(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	db, ok := s.lookupDatabase(w, r)
+	if !ok {
+		return
+	}
+
+	var deleted int64
+	for _, key := range req.Keys {
+		if _, ok := db.items[key]; ok {
+			delete(db.items, key)
+			deleted++
+		}
+	}
+
+	writeJSON(w, map[string]int64{"deleted": deleted})
+}
+
+// paginate returns the page of ids selected by the next_token and limit
+// query parameters, and the token of the next page. Tokens are offsets.
+func paginate(w http.ResponseWriter, r *http.Request, ids []string) ([]string, string, bool) {
+	offset := 0
+	if token := r.URL.Query().Get("next_token"); token != "" {
+		var err error
+		offset, err = strconv.Atoi(token)
+		if err != nil || offset < 0 || offset > len(ids) {
+			writeError(w, http.StatusBadRequest, "invalid next_token")
+			return nil, "", false
+		}
+	}
+
+	limit := defaultPageSize
+	if v := r.URL.Query().Get("limit"); v != "" {
+		var err error
+		limit, err = strconv.Atoi(v)
+		if err != nil || limit < 1 || limit > maxPageSize {
+			writeError(w, http.StatusBadRequest, "limit must be between 1 and "+strconv.Itoa(maxPageSize))
+			return nil, "", false
+		}
+	}
+
+	end := offset + limit
+	if end >= len(ids) {
+		return ids[offset:], "", true
+	}
+
+	return ids[offset:end], strconv.Itoa(end), true
+}
+
+func copyItems(items map[string]string) map[string]string {
+	copied := make(map[string]string, len(items))
+	for key, value := range items {
+		copied[key] = value
+	}
+
+	return copied
+}
@@ -0,0 +1,257 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package fakebdcc is an in-memory fake of the Bob's Discount Cloud Company
+// API. It serves the endpoints used by the provider client, so the provider
+// can be tested and developed without network access.
+//
+// Use it in-process with net/http/httptest:
+//
+//	server := httptest.NewServer(fakebdcc.New(fakebdcc.Options{}))
+//	defer server.Close()
+//
+// or run it standalone with cmd/fakebdcc.
+package fakebdcc
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Options configure a Server. The zero value is a fast, reliable server that
+// accepts any API key.
+type Options struct {
+	// APIKey, when set, must be sent in the api_key header of every request.
+	APIKey string
+
+	// Latency is added to every response. Jitter adds a random extra delay
+	// of up to Jitter.
+	Latency time.Duration
+	Jitter  time.Duration
+
+	// FailureRate is the probability, between 0 and 1, that a request fails
+	// with 503 Service Unavailable before it is handled.
+	FailureRate float64
+
+	// Seed seeds the random source used for jitter and failures, so runs
+	// can be reproduced.
+	Seed int64
+
+	// ProvisioningDelay is how long clones and snapshots stay creating and
+	// restores stay running. Zero completes them immediately.
+	ProvisioningDelay time.Duration
+}
+
+// Fault makes matching requests fail before they are handled.
+type Fault struct {
+	// Method matches the request method. Empty matches any method.
+	Method string
+
+	// Path matches requests whose URL path starts with it. Empty matches
+	// any path.
+	Path string
+
+	// Status is the response status. Defaults to 500.
+	Status int
+
+	// Count is the number of requests to fail. Zero fails every matching
+	// request until the faults are cleared.
+	Count int
+}
+
+// Server is the fake API. It implements http.Handler and is safe for
+// concurrent use.
+type Server struct {
+	options Options
+	mux     *http.ServeMux
+
+	mu          sync.Mutex
+	rand        *rand.Rand
+	faults      []*Fault
+	nextID      int
+	databases   map[string]*database
+	snapshots   map[string]*snapshot
+	restores    map[string]*restore
+	credentials map[string]*credential
+}
+
+// New returns an empty fake server.
+func New(options Options) *Server {
+	s := &Server{
+		options:     options,
+		mux:         http.NewServeMux(),
+		rand:        rand.New(rand.NewSource(options.Seed)),
+		databases:   map[string]*database{},
+		snapshots:   map[string]*snapshot{},
+		restores:    map[string]*restore{},
+		credentials: map[string]*credential{},
+	}
+
+	s.mux.HandleFunc("GET /database", s.listDatabases)
+	s.mux.HandleFunc("POST /database", s.createDatabase)
+	s.mux.HandleFunc("GET /database/{id}", s.getDatabase)
+	s.mux.HandleFunc("PATCH /database/{id}", s.updateDatabase)
+	s.mux.HandleFunc("DELETE /database/{id}", s.deleteDatabase)
+	s.mux.HandleFunc("POST /database/{id}/clone", s.cloneDatabase)
+
+	s.mux.HandleFunc("GET /database/{id}/items", s.listItems)
+	s.mux.HandleFunc("POST /database/{id}/items", s.putItem)
+	s.mux.HandleFunc("GET /database/{id}/items/{key}", s.getItem)
+	s.mux.HandleFunc("DELETE /database/{id}/items/{key}", s.deleteItem)
+	s.mux.HandleFunc("POST /database/{id}/delete-items", s.deleteItems)
+
+	s.mux.HandleFunc("GET /database/{id}/snapshots", s.listSnapshots)
+	s.mux.HandleFunc("POST /database/{id}/snapshots", s.createSnapshot)
+	s.mux.HandleFunc("GET /database/{id}/snapshots/{snapshot_id}", s.getSnapshot)
+	s.mux.HandleFunc("PATCH /database/{id}/snapshots/{snapshot_id}", s.updateSnapshot)
+	s.mux.HandleFunc("DELETE /database/{id}/snapshots/{snapshot_id}", s.deleteSnapshot)
+
+	s.mux.HandleFunc("POST /database/{id}/restores", s.createRestore)
+	s.mux.HandleFunc("GET /database/{id}/restores/{restore_id}", s.getRestore)
+
+	s.mux.HandleFunc("GET /database/{id}/credentials", s.listCredentials)
+	s.mux.HandleFunc("POST /database/{id}/credentials", s.createCredential)
+	s.mux.HandleFunc("POST /database/{id}/credentials/{credential_id}/renew", s.renewCredential)
+	s.mux.HandleFunc("DELETE /database/{id}/credentials/{credential_id}", s.revokeCredential)
+
+	return s
+}
+
+// ServeHTTP applies latency, faults and authentication, then serves the
+// request from the in-memory state.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if delay := s.delay(); delay > 0 {
+		select {
+		case <-time.After(delay):
+		case <-r.Context().Done():
+			return
+		}
+	}
+
+	if status, ok := s.fault(r); ok {
+		writeError(w, status, "injected fault")
+		return
+	}
+
+	if s.options.APIKey != "" && r.Header.Get("api_key") != s.options.APIKey {
+		writeError(w, http.StatusUnauthorized, "invalid api_key")
+		return
+	}
+
+	s.mux.ServeHTTP(w, r)
+}
+
+// InjectFault makes requests matching f fail.
+func (s *Server) InjectFault(f Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if f.Status == 0 {
+		f.Status = http.StatusInternalServerError
+	}
+	s.faults = append(s.faults, &f)
+}
+
+// ClearFaults removes every injected fault.
+func (s *Server) ClearFaults() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.faults = nil
+}
+
+// delay returns the latency to add to the next response.
+func (s *Server) delay() time.Duration {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delay := s.options.Latency
+	if s.options.Jitter > 0 {
+		delay += time.Duration(s.rand.Int63n(int64(s.options.Jitter)))
+	}
+
+	return delay
+}
+
+// fault returns the status to fail r with, if any.
+func (s *Server) fault(r *http.Request) (int, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, f := range s.faults {
+		if f.Method != "" && f.Method != r.Method {
+			continue
+		}
+		if !strings.HasPrefix(r.URL.Path, f.Path) {
+			continue
+		}
+
+		if f.Count > 0 {
+			f.Count--
+			if f.Count == 0 {
+				s.faults = append(s.faults[:i], s.faults[i+1:]...)
+			}
+		}
+
+		return f.Status, true
+	}
+
+	if s.options.FailureRate > 0 && s.rand.Float64() < s.options.FailureRate {
+		return http.StatusServiceUnavailable, true
+	}
+
+	return 0, false
+}
+
+// newID returns a new unique ID with prefix. Callers must hold s.mu.
+func (s *Server) newID(prefix string) string {
+	s.nextID++
+	return fmt.Sprintf("%s-%08x", prefix, s.nextID)
+}
+
+// ready reports whether an operation started at start has completed, and
+// the completed fraction of it.
+func (s *Server) ready(start time.Time) (bool, float64) {
+	if s.options.ProvisioningDelay <= 0 {
+		return true, 1
+	}
+
+	elapsed := time.Since(start)
+	if elapsed >= s.options.ProvisioningDelay {
+		return true, 1
+	}
+
+	return false, float64(elapsed) / float64(s.options.ProvisioningDelay)
+}
+
+// now returns the current time as the API formats it.
+func now() string {
+	return time.Now().UTC().Format(time.RFC3339)
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]string{"error": message})
+}
+
+// decode reads the JSON request body into v, failing the request with 400 if
+// it cannot be decoded.
+func decode(w http.ResponseWriter, r *http.Request, v any) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return false
+	}
+
+	return true
+}
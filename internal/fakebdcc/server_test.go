@@ -0,0 +1,334 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakebdcc_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"terraform-provider-hashicups/internal/fakebdcc"
+	"terraform-provider-hashicups/internal/provider"
+)
+
+// testClient starts a fake server and returns a provider client for it.
+func testClient(t *testing.T, options fakebdcc.Options) (*fakebdcc.Server, *provider.Client) {
+	t.Helper()
+
+	fake := fakebdcc.New(options)
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	return fake, &provider.Client{HostURL: server.URL, HTTPClient: server.Client(), Token: options.APIKey}
+}
+
+func TestServer_Databases(t *testing.T) {
+	_, client := testClient(t, fakebdcc.Options{})
+
+	db, err := client.CreateDatabase(provider.CreateDatabaseRequest{Name: "orders", DeletionProtection: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if db.Tier != "standard" || db.StorageGB != 10 || db.EngineVersion != "2.1" || db.Status != "available" {
+		t.Errorf("got database %+v, want standard tier defaults", db)
+	}
+
+	db, err = client.UpdateDatabase(provider.UpdateDatabaseRequest{
+		Name:      "orders",
+		Tags:      map[string]string{"env": "test"},
+		Tier:      "premium",
+		StorageGB: 250,
+	}, db.Id)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if db.Tier != "premium" || db.StorageGB != 250 || db.Tags["env"] != "test" || *db.DeletionProtection {
+		t.Errorf("got database %+v, want it updated", db)
+	}
+
+	if _, err := client.UpdateDatabase(provider.UpdateDatabaseRequest{Name: "orders", StorageGB: 100}, db.Id); err == nil {
+		t.Error("expected decreasing storage to fail")
+	}
+
+	for i := 0; i < 3; i++ {
+		if _, err := client.CreateDatabase(provider.CreateDatabaseRequest{Name: fmt.Sprintf("users-%d", i)}); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	databases, err := client.ListAllDatabases(provider.ListDatabasesRequest{NamePrefix: "users-", Limit: 2})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(databases) != 3 {
+		t.Errorf("got %d databases, want 3", len(databases))
+	}
+
+	if err := client.DeleteDatabase(db.Id); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, err := client.GetDatabase(db.Id); err == nil || !strings.Contains(err.Error(), "status: 404") {
+		t.Errorf("got error %v, want 404", err)
+	}
+}
+
+func TestServer_DeletionProtection(t *testing.T) {
+	fake, client := testClient(t, fakebdcc.Options{})
+
+	db, err := client.CreateDatabase(provider.CreateDatabaseRequest{Name: "orders", DeletionProtection: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := client.DeleteDatabase(db.Id); err == nil || !strings.Contains(err.Error(), "status: 409") {
+		t.Errorf("got error %v, want 409", err)
+	}
+
+	if !fake.RemoveDatabase(db.Id) {
+		t.Error("expected the database to be removed out of band")
+	}
+	if _, err := client.GetDatabase(db.Id); err == nil {
+		t.Error("expected the removed database to be gone")
+	}
+}
+
+func TestServer_Items(t *testing.T) {
+	fake, client := testClient(t, fakebdcc.Options{})
+
+	db, err := client.CreateDatabase(provider.CreateDatabaseRequest{Name: "orders"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for i := 0; i < 150; i++ {
+		key := fmt.Sprintf("users/%03d", i)
+		if _, err := client.CreateDatabaseItem(provider.CreateDatabaseItemRequest{Key: key, Value: "user"}, db.Id); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+	if _, err := client.CreateDatabaseItem(provider.CreateDatabaseItemRequest{Key: "sessions/abc", Value: "session"}, db.Id); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	item, err := client.GetDatabaseItem(db.Id, "users/007")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if item.Key != "users/007" || item.Value != "user" {
+		t.Errorf("got item %+v", item)
+	}
+
+	items, err := client.ListAllDatabaseItems("users/", db.Id)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(items) != 150 {
+		t.Errorf("got %d items, want 150", len(items))
+	}
+
+	if err := client.DeleteDatabaseItem(db.Id, "sessions/abc"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := client.DeleteDatabaseItem(db.Id, "sessions/abc"); err == nil || !strings.Contains(err.Error(), "status: 404") {
+		t.Errorf("got error %v, want 404", err)
+	}
+
+	deleted, err := client.DeleteDatabaseItems(provider.DeleteDatabaseItemsRequest{Keys: []string{"users/000", "users/001", "missing"}}, db.Id)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if deleted.Deleted != 2 {
+		t.Errorf("got %d items deleted, want 2", deleted.Deleted)
+	}
+
+	if got := len(fake.Items(db.Id)); got != 148 {
+		t.Errorf("got %d items left, want 148", got)
+	}
+}
+
+func TestServer_SnapshotsAndRestores(t *testing.T) {
+	fake, client := testClient(t, fakebdcc.Options{ProvisioningDelay: 50 * time.Millisecond})
+
+	db, err := client.CreateDatabase(provider.CreateDatabaseRequest{Name: "orders"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, err := client.CreateDatabaseItem(provider.CreateDatabaseItemRequest{Key: "a", Value: "1"}, db.Id); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	snapshot, err := client.CreateDatabaseSnapshot(provider.CreateDatabaseSnapshotRequest{Description: "before"}, db.Id)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if snapshot.Status != "creating" || snapshot.RetentionDays != 7 {
+		t.Errorf("got snapshot %+v, want creating with 7 days retention", snapshot)
+	}
+
+	if _, err := client.RestoreDatabase(provider.RestoreDatabaseRequest{SnapshotId: snapshot.Id}, db.Id); err == nil || !strings.Contains(err.Error(), "status: 409") {
+		t.Errorf("got error %v, want 409 restoring a snapshot that is still creating", err)
+	}
+
+	time.Sleep(50 * time.Millisecond)
+
+	snapshot, err = client.UpdateDatabaseSnapshot(provider.UpdateDatabaseSnapshotRequest{Description: "after", RetentionDays: 30}, db.Id, snapshot.Id)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if snapshot.Status != "available" || snapshot.Description != "after" || snapshot.RetentionDays != 30 {
+		t.Errorf("got snapshot %+v, want it available and updated", snapshot)
+	}
+
+	if _, err := client.CreateDatabaseItem(provider.CreateDatabaseItemRequest{Key: "b", Value: "2"}, db.Id); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	clone, err := client.CloneDatabase(provider.CloneDatabaseRequest{
+		CreateDatabaseRequest: provider.CreateDatabaseRequest{Name: "orders-copy"},
+		SnapshotId:            snapshot.Id,
+	}, db.Id)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if clone.Status != "creating" || clone.SourceDatabaseId != db.Id || clone.SourceSnapshotId != snapshot.Id {
+		t.Errorf("got clone %+v, want it creating from the snapshot", clone)
+	}
+	if got := fake.Items(clone.Id); !reflect.DeepEqual(got, map[string]string{"a": "1"}) {
+		t.Errorf("got clone items %v, want the snapshot items", got)
+	}
+
+	restore, err := client.RestoreDatabase(provider.RestoreDatabaseRequest{SnapshotId: snapshot.Id, TargetName: "orders-restored"}, db.Id)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if restore.Status != "running" || restore.TargetDatabaseId == db.Id {
+		t.Errorf("got restore %+v, want it running into a new database", restore)
+	}
+
+	time.Sleep(50 * time.Millisecond)
+
+	restore, err = client.GetDatabaseRestore(db.Id, restore.Id)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if restore.Status != "completed" || restore.ProgressPercent != 100 || restore.ItemsRestored != 1 {
+		t.Errorf("got restore %+v, want it completed", restore)
+	}
+
+	snapshots, err := client.ListAllDatabaseSnapshots(db.Id)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(snapshots) != 1 {
+		t.Errorf("got %d snapshots, want 1", len(snapshots))
+	}
+
+	if err := client.DeleteDatabaseSnapshot(db.Id, snapshot.Id); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, err := client.GetDatabaseSnapshot(db.Id, snapshot.Id); err == nil {
+		t.Error("expected the deleted snapshot to be gone")
+	}
+}
+
+func TestServer_Credentials(t *testing.T) {
+	_, client := testClient(t, fakebdcc.Options{})
+
+	db, err := client.CreateDatabase(provider.CreateDatabaseRequest{Name: "orders"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, err := client.CreateDatabaseCredential(provider.CreateDatabaseCredentialRequest{Scope: "root"}, db.Id); err == nil {
+		t.Error("expected an unknown scope to fail")
+	}
+
+	credential, err := client.CreateDatabaseCredential(provider.CreateDatabaseCredentialRequest{Scope: "read", TTLSeconds: 60}, db.Id)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if credential.Key == "" || credential.DatabaseId != db.Id {
+		t.Errorf("got credential %+v", credential)
+	}
+
+	credentials, err := client.ListDatabaseCredentials(db.Id)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(credentials) != 1 || credentials[0].Id != credential.Id || credentials[0].Key != "" {
+		t.Errorf("got credentials %+v, want the credential without its key", credentials)
+	}
+
+	if _, err := client.RenewDatabaseCredential(db.Id, credential.Id); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := client.RevokeDatabaseCredential(db.Id, credential.Id); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, err := client.RenewDatabaseCredential(db.Id, credential.Id); err == nil {
+		t.Error("expected renewing a revoked credential to fail")
+	}
+}
+
+func TestServer_APIKey(t *testing.T) {
+	_, client := testClient(t, fakebdcc.Options{APIKey: "secret"})
+
+	if _, err := client.CreateDatabase(provider.CreateDatabaseRequest{Name: "orders"}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	client.Token = "wrong"
+	if _, err := client.CreateDatabase(provider.CreateDatabaseRequest{Name: "orders"}); err == nil || !strings.Contains(err.Error(), "status: 401") {
+		t.Errorf("got error %v, want 401", err)
+	}
+}
+
+func TestServer_Faults(t *testing.T) {
+	fake, client := testClient(t, fakebdcc.Options{})
+
+	fake.InjectFault(fakebdcc.Fault{Method: http.MethodPost, Path: "/database", Status: http.StatusTooManyRequests, Count: 2})
+
+	for i := 0; i < 2; i++ {
+		if _, err := client.CreateDatabase(provider.CreateDatabaseRequest{Name: "orders"}); err == nil || !strings.Contains(err.Error(), "status: 429") {
+			t.Errorf("attempt %d: got error %v, want 429", i, err)
+		}
+	}
+	if _, err := client.CreateDatabase(provider.CreateDatabaseRequest{Name: "orders"}); err != nil {
+		t.Errorf("got error %v after the fault was used up", err)
+	}
+
+	fake.InjectFault(fakebdcc.Fault{})
+	if _, err := client.ListDatabases(provider.ListDatabasesRequest{}); err == nil || !strings.Contains(err.Error(), "status: 500") {
+		t.Errorf("got error %v, want 500", err)
+	}
+
+	fake.ClearFaults()
+	if _, err := client.ListDatabases(provider.ListDatabasesRequest{}); err != nil {
+		t.Errorf("got error %v after clearing faults", err)
+	}
+}
+
+func TestServer_FailureRate(t *testing.T) {
+	_, client := testClient(t, fakebdcc.Options{FailureRate: 1})
+
+	if _, err := client.ListDatabases(provider.ListDatabasesRequest{}); err == nil || !strings.Contains(err.Error(), "status: 503") {
+		t.Errorf("got error %v, want 503", err)
+	}
+}
+
+func TestServer_Latency(t *testing.T) {
+	_, client := testClient(t, fakebdcc.Options{Latency: 20 * time.Millisecond})
+
+	start := time.Now()
+	if _, err := client.ListDatabases(provider.ListDatabasesRequest{}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if elapsed := time.Since(start); elapsed < 20*time.Millisecond {
+		t.Errorf("got a response after %s, want at least 20ms", elapsed)
+	}
+}
@@ -0,0 +1,336 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakebdcc
+
+import (
+	"net/http"
+	"sort"
+	"time"
+)
+
+const (
+	restoreStatusRunning   = "running"
+	restoreStatusCompleted = "completed"
+
+	defaultRetentionDays = 7
+	maxRetentionDays     = 365
+)
+
+// Snapshot is the API representation of a database snapshot.
+type Snapshot struct {
+	Id            string `json:"id"`
+	DatabaseId    string `json:"database_id"`
+	Description   string `json:"description"`
+	Status        string `json:"status"`
+	RetentionDays int64  `json:"retention_days"`
+	CreatedAt     string `json:"created_at"`
+	ExpiresAt     string `json:"expires_at"`
+}
+
+// Restore is the API representation of a snapshot restore.
+type Restore struct {
+	Id               string `json:"id"`
+	DatabaseId       string `json:"database_id"`
+	SnapshotId       string `json:"snapshot_id"`
+	TargetDatabaseId string `json:"target_database_id"`
+	Status           string `json:"status"`
+	ProgressPercent  int64  `json:"progress_percent"`
+	ItemsRestored    int64  `json:"items_restored"`
+}
+
+type snapshotRequest struct {
+	Description   string `json:"description"`
+	RetentionDays int64  `json:"retention_days"`
+}
+
+type restoreRequest struct {
+	SnapshotId       string `json:"snapshot_id"`
+	TargetDatabaseId string `json:"target_database_id"`
+	TargetName       string `json:"target_name"`
+}
+
+// snapshot is a stored snapshot and a copy of the items it captured.
+type snapshot struct {
+	Snapshot
+	started time.Time
+	items   map[string]string
+}
+
+// restore is a stored restore of total items.
+type restore struct {
+	Restore
+	started time.Time
+	total   int64
+}
+
+// snapshotView returns the API representation of snap. Callers must hold
+// s.mu.
+func (s *Server) snapshotView(snap *snapshot) Snapshot {
+	view := snap.Snapshot
+	view.Status = statusAvailable
+	if ready, _ := s.ready(snap.started); !ready {
+		view.Status = statusCreating
+	}
+
+	return view
+}
+
+// restoreView returns the API representation of rest. Callers must hold
+// s.mu.
+func (s *Server) restoreView(rest *restore) Restore {
+	view := rest.Restore
+	ready, done := s.ready(rest.started)
+
+	view.Status = restoreStatusRunning
+	if ready {
+		view.Status = restoreStatusCompleted
+	}
+	view.ProgressPercent = int64(done * 100)
+	view.ItemsRestored = int64(done * float64(rest.total))
+
+	return view
+}
+
+// lookupSnapshot returns the snapshot of db named by the snapshot_id path
+// value, failing the request with 404 if it does not exist. Callers must
+// hold s.mu.
+func (s *Server) lookupSnapshot(w http.ResponseWriter, r *http.Request, db *database) (*snapshot, bool) {
+	snap, ok := s.snapshots[r.PathValue("snapshot_id")]
+	if !ok || snap.DatabaseId != db.Id {
+		writeError(w, http.StatusNotFound, "snapshot "+r.PathValue("snapshot_id")+" not found")
+		return nil, false
+	}
+
+	return snap, true
+}
+
+// setRetention sets the retention of snap, failing the request with 400 if
+// it is out of range. Zero keeps the current retention.
+func setRetention(w http.ResponseWriter, snap *snapshot, days int64) bool {
+	if days == 0 {
+		return true
+	}
+	if days < 1 || days > maxRetentionDays {
+		writeError(w, http.StatusBadRequest, "retention_days must be between 1 and 365")
+		return false
+	}
+
+	created, err := time.Parse(time.RFC3339, snap.CreatedAt)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return false
+	}
+
+	snap.RetentionDays = days
+	snap.ExpiresAt = created.AddDate(0, 0, int(days)).Format(time.RFC3339)
+	return true
+}
+
+func (s *Server) listSnapshots(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	db, ok := s.lookupDatabase(w, r)
+	if !ok {
+		return
+	}
+
+	var ids []string
+	for id, snap := range s.snapshots {
+		if snap.DatabaseId == db.Id {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+
+	page, next, ok := paginate(w, r, ids)
+	if !ok {
+		return
+	}
+
+	snapshots := make([]Snapshot, 0, len(page))
+	for _, id := range page {
+		snapshots = append(snapshots, s.snapshotView(s.snapshots[id]))
+	}
+
+	writeJSON(w, map[string]any{"snapshots": snapshots, "next_token": next})
+}
+
+func (s *Server) createSnapshot(w http.ResponseWriter, r *http.Request) {
+	var req snapshotRequest
+	if !decode(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	db, ok := s.lookupDatabase(w, r)
+	if !ok {
+		return
+	}
+	if s.view(db).Status != statusAvailable {
+		writeError(w, http.StatusConflict, "database "+db.Id+" is not available")
+		return
+	}
+
+	if req.RetentionDays == 0 {
+		req.RetentionDays = defaultRetentionDays
+	}
+
+	snap := &snapshot{
+		Snapshot: Snapshot{
+			Id:          s.newID("snap"),
+			DatabaseId:  db.Id,
+			Description: req.Description,
+			CreatedAt:   now(),
+		},
+		started: time.Now(),
+		items:   copyItems(db.items),
+	}
+	if !setRetention(w, snap, req.RetentionDays) {
+		return
+	}
+	s.snapshots[snap.Id] = snap
+
+	writeJSON(w, s.snapshotView(snap))
+}
+
+func (s *Server) getSnapshot(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	db, ok := s.lookupDatabase(w, r)
+	if !ok {
+		return
+	}
+	snap, ok := s.lookupSnapshot(w, r, db)
+	if !ok {
+		return
+	}
+
+	writeJSON(w, s.snapshotView(snap))
+}
+
+func (s *Server) updateSnapshot(w http.ResponseWriter, r *http.Request) {
+	var req snapshotRequest
+	if !decode(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	db, ok := s.lookupDatabase(w, r)
+	if !ok {
+		return
+	}
+	snap, ok := s.lookupSnapshot(w, r, db)
+	if !ok {
+		return
+	}
+
+	if !setRetention(w, snap, req.RetentionDays) {
+		return
+	}
+	snap.Description = req.Description
+
+	writeJSON(w, s.snapshotView(snap))
+}
+
+func (s *Server) deleteSnapshot(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	db, ok := s.lookupDatabase(w, r)
+	if !ok {
+		return
+	}
+	snap, ok := s.lookupSnapshot(w, r, db)
+	if !ok {
+		return
+	}
+
+	delete(s.snapshots, snap.Id)
+
+	writeJSON(w, map[string]any{})
+}
+
+func (s *Server) createRestore(w http.ResponseWriter, r *http.Request) {
+	var req restoreRequest
+	if !decode(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	db, ok := s.lookupDatabase(w, r)
+	if !ok {
+		return
+	}
+
+	snap, ok := s.snapshots[req.SnapshotId]
+	if !ok || snap.DatabaseId != db.Id {
+		writeError(w, http.StatusNotFound, "snapshot "+req.SnapshotId+" of database "+db.Id+" not found")
+		return
+	}
+	if s.snapshotView(snap).Status != statusAvailable {
+		writeError(w, http.StatusConflict, "snapshot "+snap.Id+" is not available")
+		return
+	}
+
+	target := db
+	switch {
+	case req.TargetDatabaseId != "" && req.TargetName != "":
+		writeError(w, http.StatusBadRequest, "only one of target_database_id and target_name may be set")
+		return
+	case req.TargetDatabaseId != "":
+		target, ok = s.databases[req.TargetDatabaseId]
+		if !ok {
+			writeError(w, http.StatusNotFound, "database "+req.TargetDatabaseId+" not found")
+			return
+		}
+	case req.TargetName != "":
+		target, ok = s.newDatabase(w, createDatabaseRequest{Name: req.TargetName}, nil, time.Time{})
+		if !ok {
+			return
+		}
+	}
+
+	// The items are restored at once; the restore only reports progress.
+	target.items = copyItems(snap.items)
+
+	rest := &restore{
+		Restore: Restore{
+			Id:               s.newID("restore"),
+			DatabaseId:       db.Id,
+			SnapshotId:       snap.Id,
+			TargetDatabaseId: target.Id,
+		},
+		started: time.Now(),
+		total:   int64(len(snap.items)),
+	}
+	s.restores[rest.Id] = rest
+
+	writeJSON(w, s.restoreView(rest))
+}
+
+func (s *Server) getRestore(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	db, ok := s.lookupDatabase(w, r)
+	if !ok {
+		return
+	}
+
+	rest, ok := s.restores[r.PathValue("restore_id")]
+	if !ok || rest.DatabaseId != db.Id {
+		writeError(w, http.StatusNotFound, "restore "+r.PathValue("restore_id")+" not found")
+		return
+	}
+
+	writeJSON(w, s.restoreView(rest))
+}
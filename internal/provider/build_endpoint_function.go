@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var (
+	_ function.Function = BuildEndpointFunction{}
+)
+
+func NewBuildEndpointFunction() function.Function {
+	return BuildEndpointFunction{}
+}
+
+type BuildEndpointFunction struct{}
+
+func (r BuildEndpointFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "build_endpoint"
+}
+
+func (r BuildEndpointFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Build a regional API endpoint",
+		MarkdownDescription: "Returns the Bob's Discount Cloud Company API endpoint for a region, suitable for the provider `host` argument.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "region",
+				MarkdownDescription: "Region to build the endpoint for, e.g. `us-east-1`",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (r BuildEndpointFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var region string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &region))
+
+	if resp.Error != nil {
+		return
+	}
+
+	endpoint, err := buildEndpoint(region)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, endpoint))
+}
@@ -0,0 +1,402 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// cassetteModeEnvVar enables recording or replaying API traffic. One of
+	// cassetteModeRecord or cassetteModeReplay.
+	cassetteModeEnvVar = "BDCC_CASSETTE_MODE"
+
+	// cassettePathEnvVar is the cassette file traffic is recorded to or
+	// replayed from.
+	cassettePathEnvVar = "BDCC_CASSETTE"
+
+	cassetteModeRecord = "record"
+	cassetteModeReplay = "replay"
+
+	// redactedHeaderValue replaces secret header values and response fields
+	// in cassettes.
+	redactedHeaderValue = "REDACTED"
+
+	// cassetteLockTimeout bounds how long a recorder waits for another
+	// provider process to finish writing the cassette.
+	cassetteLockTimeout = 10 * time.Second
+
+	// cassetteLockRetryInterval is how often a recorder retries taking the
+	// cassette lock.
+	cassetteLockRetryInterval = 10 * time.Millisecond
+)
+
+// cassetteRedactedHeaders are recorded with their value replaced by
+// redactedHeaderValue.
+var cassetteRedactedHeaders = []string{"api_key"}
+
+// cassetteRedactedFields lists the secret fields of JSON response bodies,
+// keyed by a pattern matching the paths that return them. The fields are
+// recorded with their value replaced by redactedHeaderValue, at any depth.
+var cassetteRedactedFields = map[*regexp.Regexp][]string{
+	// Credential keys, returned on issue, renewal and listing.
+	regexp.MustCompile(`^/database/[^/]+/credentials(/|$)`): {"key"},
+}
+
+// cassette is a recording of API traffic.
+type cassette struct {
+	Interactions []cassetteInteraction `json:"interactions"`
+}
+
+// cassetteInteraction is a request and the response it got.
+type cassetteInteraction struct {
+	Request  cassetteRequest  `json:"request"`
+	Response cassetteResponse `json:"response"`
+}
+
+// cassetteRequest is a recorded request. URI is the path and query, so a
+// cassette can be replayed against any host.
+type cassetteRequest struct {
+	Method  string      `json:"method"`
+	URI     string      `json:"uri"`
+	Headers http.Header `json:"headers,omitempty"`
+	Body    string      `json:"body,omitempty"`
+}
+
+// cassetteResponse is a recorded response.
+type cassetteResponse struct {
+	StatusCode int         `json:"status_code"`
+	Headers    http.Header `json:"headers,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+// transportFromEnv wraps next in a recording or replaying transport when
+// cassetteModeEnvVar is set, and returns next otherwise.
+func transportFromEnv(next http.RoundTripper) (http.RoundTripper, error) {
+	mode := os.Getenv(cassetteModeEnvVar)
+	if mode == "" {
+		return next, nil
+	}
+
+	path := os.Getenv(cassettePathEnvVar)
+	if path == "" {
+		return nil, fmt.Errorf("%s must be set when %s is set", cassettePathEnvVar, cassetteModeEnvVar)
+	}
+
+	switch mode {
+	case cassetteModeRecord:
+		return NewRecordingTransport(path, next)
+	case cassetteModeReplay:
+		return NewReplayTransport(path)
+	default:
+		return nil, fmt.Errorf("%s must be %q or %q, got %q", cassetteModeEnvVar, cassetteModeRecord, cassetteModeReplay, mode)
+	}
+}
+
+// RecordingTransport - An http.RoundTripper that records every request and
+// response to a cassette file, with secret headers and response fields
+// redacted
+type RecordingTransport struct {
+	path string
+	next http.RoundTripper
+
+	mu sync.Mutex
+}
+
+// NewRecordingTransport - Records the traffic sent through next to the
+// cassette at path. An existing cassette is appended to, as Terraform starts
+// the provider once per command; delete it to start a new recording.
+func NewRecordingTransport(path string, next http.RoundTripper) (*RecordingTransport, error) {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	// Fail early on a cassette that cannot be appended to.
+	if _, err := loadCassette(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+
+	return &RecordingTransport{path: path, next: next}, nil
+}
+
+// RoundTrip sends req through the wrapped transport and records the
+// exchange. Each exchange is appended to the cassette on disk right away, as
+// the provider process can be stopped at any time and several provider
+// processes may record to the same cassette.
+func (t *RecordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	requestBody, err := readBody(&req.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	responseBody, err := readBody(&res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	interaction := cassetteInteraction{
+		Request: cassetteRequest{
+			Method:  req.Method,
+			URI:     req.URL.RequestURI(),
+			Headers: redactHeaders(req.Header),
+			Body:    requestBody,
+		},
+		Response: cassetteResponse{
+			StatusCode: res.StatusCode,
+			Headers:    res.Header.Clone(),
+			Body:       redactBody(req.URL.Path, responseBody),
+		},
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if err := appendToCassette(t.path, interaction); err != nil {
+		return nil, fmt.Errorf("recording cassette %s: %w", t.path, err)
+	}
+
+	return res, nil
+}
+
+// ReplayTransport - An http.RoundTripper that serves recorded responses
+// from a cassette file without sending any request
+type ReplayTransport struct {
+	mu           sync.Mutex
+	interactions []cassetteInteraction
+	used         []bool
+}
+
+// NewReplayTransport - Replays the cassette at path
+func NewReplayTransport(path string) (*ReplayTransport, error) {
+	recorded, err := loadCassette(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ReplayTransport{
+		interactions: recorded.Interactions,
+		used:         make([]bool, len(recorded.Interactions)),
+	}, nil
+}
+
+// RoundTrip returns the response of the first unused interaction recorded
+// for the same method, path, query and body. Repeated requests, such as
+// status polls, get their responses in recorded order.
+func (t *ReplayTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readBody(&req.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for i, interaction := range t.interactions {
+		if t.used[i] {
+			continue
+		}
+		if interaction.Request.Method != req.Method || interaction.Request.URI != req.URL.RequestURI() || interaction.Request.Body != body {
+			continue
+		}
+
+		t.used[i] = true
+
+		header := interaction.Response.Headers.Clone()
+		if header == nil {
+			header = http.Header{}
+		}
+
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+			StatusCode:    interaction.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        header,
+			Body:          io.NopCloser(strings.NewReader(interaction.Response.Body)),
+			ContentLength: int64(len(interaction.Response.Body)),
+			Request:       req,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("no recorded response left for %s %s", req.Method, req.URL.RequestURI())
+}
+
+// readBody reads and replaces *body, so it can still be sent or decoded.
+func readBody(body *io.ReadCloser) (string, error) {
+	if *body == nil || *body == http.NoBody {
+		return "", nil
+	}
+
+	data, err := io.ReadAll(*body)
+	if err != nil {
+		return "", err
+	}
+	(*body).Close()
+	*body = io.NopCloser(bytes.NewReader(data))
+
+	return string(data), nil
+}
+
+// redactHeaders returns a copy of header with secret values replaced.
+func redactHeaders(header http.Header) http.Header {
+	redacted := header.Clone()
+	for _, name := range cassetteRedactedHeaders {
+		if redacted.Get(name) != "" {
+			redacted.Set(name, redactedHeaderValue)
+		}
+	}
+
+	return redacted
+}
+
+// redactBody returns body with the secret fields returned by path replaced.
+// Bodies that are not JSON are returned unchanged.
+func redactBody(path, body string) string {
+	var fields []string
+	for pattern, redacted := range cassetteRedactedFields {
+		if pattern.MatchString(path) {
+			fields = append(fields, redacted...)
+		}
+	}
+	if len(fields) == 0 {
+		return body
+	}
+
+	var decoded any
+	if err := json.Unmarshal([]byte(body), &decoded); err != nil {
+		return body
+	}
+
+	redactFields(decoded, fields)
+
+	encoded, err := json.Marshal(decoded)
+	if err != nil {
+		return body
+	}
+
+	return string(encoded)
+}
+
+// redactFields replaces the values of fields in every object within value.
+func redactFields(value any, fields []string) {
+	switch v := value.(type) {
+	case map[string]any:
+		for name, field := range v {
+			for _, secret := range fields {
+				if name == secret {
+					v[name] = redactedHeaderValue
+				}
+			}
+			redactFields(field, fields)
+		}
+	case []any:
+		for _, element := range v {
+			redactFields(element, fields)
+		}
+	}
+}
+
+// appendToCassette adds interaction to the cassette at path. The cassette is
+// re-read under a lock, so concurrent recorders do not overwrite each
+// other's interactions.
+func appendToCassette(path string, interaction cassetteInteraction) error {
+	unlock, err := lockCassette(path)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	recorded, err := loadCassette(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		recorded, err = &cassette{}, nil
+	}
+	if err != nil {
+		return err
+	}
+
+	recorded.Interactions = append(recorded.Interactions, interaction)
+
+	return saveCassette(path, *recorded)
+}
+
+// lockCassette takes an exclusive lock on the cassette at path and returns a
+// function releasing it. The lock is a file next to the cassette, so it also
+// works across provider processes.
+func lockCassette(path string) (func(), error) {
+	lockPath := path + ".lock"
+	deadline := time.Now().Add(cassetteLockTimeout)
+
+	for {
+		file, err := os.OpenFile(lockPath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+		if err == nil {
+			file.Close()
+			return func() { os.Remove(lockPath) }, nil
+		}
+		if !errors.Is(err, fs.ErrExist) {
+			return nil, err
+		}
+
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("timed out waiting for lock %s; remove it if no provider is recording", lockPath)
+		}
+		time.Sleep(cassetteLockRetryInterval)
+	}
+}
+
+// loadCassette reads the cassette at path.
+func loadCassette(path string) (*cassette, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading cassette %s: %w", path, err)
+	}
+
+	var recorded cassette
+	if err := json.Unmarshal(data, &recorded); err != nil {
+		return nil, fmt.Errorf("decoding cassette %s: %w", path, err)
+	}
+
+	return &recorded, nil
+}
+
+// saveCassette writes recorded to path, replacing it once fully written.
+func saveCassette(path string, recorded cassette) error {
+	data, err := json.MarshalIndent(recorded, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	file, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+
+	if _, err := file.Write(append(data, '\n')); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(file.Name(), path)
+}
@@ -0,0 +1,248 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"errors"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"terraform-provider-hashicups/internal/fakebdcc"
+)
+
+// testCassetteCalls makes the calls recorded and replayed by the cassette
+// tests and returns what they got back, and the key of the credential it
+// issued.
+func testCassetteCalls(t *testing.T, client *Client) ([]any, string) {
+	t.Helper()
+
+	database, err := client.CreateDatabase(CreateDatabaseRequest{Name: "orders"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, err := client.CreateDatabaseItem(CreateDatabaseItemRequest{Key: "users/alice", Value: "alice"}, database.Id); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	item, err := client.GetDatabaseItem(database.Id, "users/alice")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	credential, err := client.CreateDatabaseCredential(CreateDatabaseCredentialRequest{Scope: "read"}, database.Id)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	_, err = client.GetDatabase("db-missing")
+	if !IsNotFound(err) {
+		t.Fatalf("got error %v, want not found", err)
+	}
+
+	return []any{database, item, credential.Id, credential.ExpiresAt, err.Error()}, credential.Key
+}
+
+func TestCassette_RecordAndReplay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	host, api_key := "", "s3cr3t-api-key"
+
+	server := httptest.NewServer(fakebdcc.New(fakebdcc.Options{APIKey: api_key}))
+	host = server.URL
+
+	t.Setenv(cassetteModeEnvVar, cassetteModeRecord)
+	t.Setenv(cassettePathEnvVar, path)
+
+	client, err := NewClient(&host, &api_key)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	recorded, key := testCassetteCalls(t, client)
+	server.Close()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if strings.Contains(string(data), api_key) {
+		t.Error("cassette contains the API key")
+	}
+	if key == "" || strings.Contains(string(data), key) {
+		t.Errorf("cassette contains the credential key %q", key)
+	}
+	if !strings.Contains(string(data), redactedHeaderValue) {
+		t.Error("cassette does not contain the redacted api_key header")
+	}
+
+	// The server is gone, so every response must come from the cassette.
+	t.Setenv(cassetteModeEnvVar, cassetteModeReplay)
+
+	client, err = NewClient(&host, &api_key)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	replayed, replayedKey := testCassetteCalls(t, client)
+
+	if !reflect.DeepEqual(replayed, recorded) {
+		t.Errorf("got replayed %#v, want %#v", replayed, recorded)
+	}
+	if replayedKey != redactedHeaderValue {
+		t.Errorf("got replayed credential key %q, want %q", replayedKey, redactedHeaderValue)
+	}
+
+	_, err = client.GetDatabase("db-missing")
+	if err == nil || !strings.Contains(err.Error(), "no recorded response left for GET /database/db-missing") {
+		t.Errorf("got error %v, want no recorded response", err)
+	}
+}
+
+func TestRecordingTransport_Appends(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassette.json")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"id": "db-1", "name": "orders"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	// Both transports are created before either records, like the provider
+	// processes of aliased provider configurations.
+	var clients []*Client
+	for i := 0; i < 2; i++ {
+		transport, err := NewRecordingTransport(path, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		clients = append(clients, &Client{HostURL: server.URL, HTTPClient: &http.Client{Transport: transport}})
+	}
+
+	const requests = 10
+
+	var wg sync.WaitGroup
+	for _, client := range clients {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < requests; i++ {
+				if _, err := client.GetDatabase("db-1"); err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	recorded, err := loadCassette(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got, want := len(recorded.Interactions), len(clients)*requests; got != want {
+		t.Errorf("got %d interactions, want %d", got, want)
+	}
+	if _, err := os.Stat(path + ".lock"); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("got lock file stat error %v, want it removed", err)
+	}
+}
+
+func TestRedactBody(t *testing.T) {
+	cases := map[string]struct {
+		path     string
+		body     string
+		expected string
+	}{
+		"credential": {
+			path:     "/database/db-1/credentials",
+			body:     `{"id": "cred-1", "key": "s3cr3t"}`,
+			expected: `{"id":"cred-1","key":"REDACTED"}`,
+		},
+		"renewed credential": {
+			path:     "/database/db-1/credentials/cred-1/renew",
+			body:     `{"id": "cred-1", "key": "s3cr3t"}`,
+			expected: `{"id":"cred-1","key":"REDACTED"}`,
+		},
+		"credential list": {
+			path:     "/database/db-1/credentials",
+			body:     `{"credentials": [{"id": "cred-1", "key": "s3cr3t"}]}`,
+			expected: `{"credentials":[{"id":"cred-1","key":"REDACTED"}]}`,
+		},
+		"item keys are kept": {
+			path:     "/database/db-1/items/users%2Falice",
+			body:     `{"key": "users/alice", "value": "alice"}`,
+			expected: `{"key": "users/alice", "value": "alice"}`,
+		},
+		"not json": {
+			path:     "/database/db-1/credentials",
+			body:     "internal error",
+			expected: "internal error",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := redactBody(tc.path, tc.body); got != tc.expected {
+				t.Errorf("got %s, want %s", got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestTransportFromEnv(t *testing.T) {
+	cases := map[string]struct {
+		mode        string
+		path        string
+		expectError string
+	}{
+		"disabled": {},
+		"record": {
+			mode: cassetteModeRecord,
+			path: filepath.Join(t.TempDir(), "cassette.json"),
+		},
+		"missing path": {
+			mode:        cassetteModeRecord,
+			expectError: "BDCC_CASSETTE must be set",
+		},
+		"unknown mode": {
+			mode:        "rewind",
+			path:        "cassette.json",
+			expectError: `BDCC_CASSETTE_MODE must be "record" or "replay"`,
+		},
+		"missing cassette": {
+			mode:        cassetteModeReplay,
+			path:        filepath.Join(t.TempDir(), "missing.json"),
+			expectError: "reading cassette",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Setenv(cassetteModeEnvVar, tc.mode)
+			t.Setenv(cassettePathEnvVar, tc.path)
+
+			transport, err := transportFromEnv(http.DefaultTransport)
+
+			if tc.expectError != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.expectError) {
+					t.Fatalf("got error %v, want %q", err, tc.expectError)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			switch tc.mode {
+			case "":
+				if transport != http.DefaultTransport {
+					t.Errorf("got transport %T, want the default transport", transport)
+				}
+			case cassetteModeRecord:
+				if _, ok := transport.(*RecordingTransport); !ok {
+					t.Errorf("got transport %T, want *RecordingTransport", transport)
+				}
+			}
+		})
+	}
+}
@@ -2,9 +2,13 @@ package provider
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"net/url"
+	"os"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -12,11 +16,19 @@ import (
 // HostURL - Default Hashicups URL
 const HostURL string = "https://api.us-east-1.whybobs.com"
 
+// userAgentExtraEnvVar holds extra product tokens appended to the User-Agent
+// header, such as the name and version of a tool wrapping Terraform.
+const userAgentExtraEnvVar = "BDCC_USER_AGENT_EXTRA"
+
 // Client -
 type Client struct {
 	HostURL    string
 	HTTPClient *http.Client
 	Token      string
+	// UserAgent is sent with every request. Go's default is sent when empty.
+	UserAgent string
+	// DefaultTags are merged into the tags of every resource that supports them.
+	DefaultTags map[string]string
 }
 
 // NewClient -
@@ -32,6 +44,12 @@ func NewClient(host, api_key *string) (*Client, error) {
 		c.HostURL = *host
 	}
 
+	transport, err := transportFromEnv(http.DefaultTransport)
+	if err != nil {
+		return nil, err
+	}
+	c.HTTPClient.Transport = transport
+
 	// If username or password not provided, return empty client
 	if api_key == nil {
 		return &c, nil
@@ -40,10 +58,28 @@ func NewClient(host, api_key *string) (*Client, error) {
 	return &c, nil
 }
 
+// UserAgent - The User-Agent of the provider at providerVersion run by
+// Terraform at terraformVersion, followed by the tokens in
+// BDCC_USER_AGENT_EXTRA
+func UserAgent(providerVersion, terraformVersion string) string {
+	tokens := []string{"terraform-provider-bobsdiscountcloudco/" + providerVersion}
+	if terraformVersion != "" {
+		tokens = append(tokens, "terraform/"+terraformVersion)
+	}
+	if extra := strings.TrimSpace(os.Getenv(userAgentExtraEnvVar)); extra != "" {
+		tokens = append(tokens, extra)
+	}
+
+	return strings.Join(tokens, " ")
+}
+
 func (c *Client) doRequest(req *http.Request) ([]byte, error) {
 	token := c.Token
 
 	req.Header.Set("api_key", token)
+	if c.UserAgent != "" {
+		req.Header.Set("User-Agent", c.UserAgent)
+	}
 
 	res, err := c.HTTPClient.Do(req)
 	if err != nil {
@@ -57,12 +93,28 @@ func (c *Client) doRequest(req *http.Request) ([]byte, error) {
 	}
 
 	if res.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("status: %d, body: %s", res.StatusCode, body)
+		return nil, &APIError{StatusCode: res.StatusCode, Body: string(body)}
 	}
 
 	return body, err
 }
 
+// APIError - An unsuccessful response from the API
+type APIError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("status: %d, body: %s", e.StatusCode, e.Body)
+}
+
+// IsNotFound - Reports whether err is an API response for something that does not exist
+func IsNotFound(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
+
 func (c *Client) CreateDatabaseItem(createDatabaseItemRequest CreateDatabaseItemRequest, database_id string) (*CreateDatabaseItemResponse, error) {
 	rb, err := json.Marshal(createDatabaseItemRequest)
 	if err != nil {
@@ -88,6 +140,68 @@ func (c *Client) CreateDatabaseItem(createDatabaseItemRequest CreateDatabaseItem
 	return &order, nil
 }
 
+// GetDatabaseItem - Get a single item of a database
+func (c *Client) GetDatabaseItem(database_id, key string) (*DatabaseItem, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/database/%s/items/%s", c.HostURL, database_id, url.PathEscape(key)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	item := DatabaseItem{}
+	err = json.Unmarshal(body, &item)
+	if err != nil {
+		return nil, err
+	}
+
+	return &item, nil
+}
+
+// DeleteDatabaseItem - Deletes a single item of a database
+func (c *Client) DeleteDatabaseItem(database_id, key string) error {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/database/%s/items/%s", c.HostURL, database_id, url.PathEscape(key)), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteDatabaseItems - Deletes a batch of items of a database
+func (c *Client) DeleteDatabaseItems(deleteDatabaseItemsRequest DeleteDatabaseItemsRequest, database_id string) (*DeleteDatabaseItemsResponse, error) {
+	rb, err := json.Marshal(deleteDatabaseItemsRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/database/%s/delete-items", c.HostURL, database_id), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	deleted := DeleteDatabaseItemsResponse{}
+	err = json.Unmarshal(body, &deleted)
+	if err != nil {
+		return nil, err
+	}
+
+	return &deleted, nil
+}
+
 // CreateDatabase - Create new order
 func (c *Client) CreateDatabase(createDatabaseRequest CreateDatabaseRequest) (*CreateDatabaseResponse, error) {
 	rb, err := json.Marshal(createDatabaseRequest)
@@ -114,6 +228,32 @@ func (c *Client) CreateDatabase(createDatabaseRequest CreateDatabaseRequest) (*C
 	return &order, nil
 }
 
+// CloneDatabase - Create a new database as a copy of an existing database or one of its snapshots
+func (c *Client) CloneDatabase(cloneDatabaseRequest CloneDatabaseRequest, source_database_id string) (*CreateDatabaseResponse, error) {
+	rb, err := json.Marshal(cloneDatabaseRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/database/%s/clone", c.HostURL, source_database_id), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	database := CreateDatabaseResponse{}
+	err = json.Unmarshal(body, &database)
+	if err != nil {
+		return nil, err
+	}
+
+	return &database, nil
+}
+
 // GetDatabase - Get database
 func (c *Client) GetDatabase(database_id string) (*CreateDatabaseResponse, error) {
 	req, err := http.NewRequest("GET", fmt.Sprintf("%s/database/%s", c.HostURL, database_id), nil)
@@ -135,13 +275,24 @@ func (c *Client) GetDatabase(database_id string) (*CreateDatabaseResponse, error
 	return &database, nil
 }
 
-// ListDatabases
-func (c *Client) ListDatabases() (*ListDatabasesResponse, error) {
+// ListDatabases - Lists one page of databases
+func (c *Client) ListDatabases(listDatabasesRequest ListDatabasesRequest) (*ListDatabasesResponse, error) {
+	query := url.Values{}
+	if listDatabasesRequest.NamePrefix != "" {
+		query.Set("name_prefix", listDatabasesRequest.NamePrefix)
+	}
+	if listDatabasesRequest.NextToken != "" {
+		query.Set("next_token", listDatabasesRequest.NextToken)
+	}
+	if listDatabasesRequest.Limit > 0 {
+		query.Set("limit", strconv.FormatInt(listDatabasesRequest.Limit, 10))
+	}
 
 	req, err := http.NewRequest("GET", fmt.Sprintf("%s/database", c.HostURL), nil)
 	if err != nil {
 		return nil, err
 	}
+	req.URL.RawQuery = query.Encode()
 
 	body, err := c.doRequest(req)
 	if err != nil {
@@ -157,6 +308,104 @@ func (c *Client) ListDatabases() (*ListDatabasesResponse, error) {
 	return &order, nil
 }
 
+// ListAllDatabases - Lists every database, following pagination
+func (c *Client) ListAllDatabases(listDatabasesRequest ListDatabasesRequest) ([]Database, error) {
+	var databases []Database
+
+	for {
+		page, err := c.ListDatabases(listDatabasesRequest)
+		if err != nil {
+			return nil, err
+		}
+
+		databases = append(databases, page.Databases...)
+
+		if page.NextToken == "" {
+			return databases, nil
+		}
+		listDatabasesRequest.NextToken = page.NextToken
+	}
+}
+
+// ListDatabaseItems - Lists one page of the items of a database
+func (c *Client) ListDatabaseItems(listDatabaseItemsRequest ListDatabaseItemsRequest, database_id string) (*ListDatabaseItemsResponse, error) {
+	query := url.Values{}
+	if listDatabaseItemsRequest.Prefix != "" {
+		query.Set("prefix", listDatabaseItemsRequest.Prefix)
+	}
+	if listDatabaseItemsRequest.NextToken != "" {
+		query.Set("next_token", listDatabaseItemsRequest.NextToken)
+	}
+	if listDatabaseItemsRequest.Limit > 0 {
+		query.Set("limit", strconv.FormatInt(listDatabaseItemsRequest.Limit, 10))
+	}
+
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/database/%s/items", c.HostURL, database_id), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.URL.RawQuery = query.Encode()
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	items := ListDatabaseItemsResponse{}
+	err = json.Unmarshal(body, &items)
+	if err != nil {
+		return nil, err
+	}
+
+	return &items, nil
+}
+
+// ListAllDatabaseItems - Lists every item of a database whose key starts with prefix, following pagination
+func (c *Client) ListAllDatabaseItems(prefix, database_id string) ([]DatabaseItem, error) {
+	var items []DatabaseItem
+	listDatabaseItemsRequest := ListDatabaseItemsRequest{Prefix: prefix}
+
+	for {
+		page, err := c.ListDatabaseItems(listDatabaseItemsRequest, database_id)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, page.Items...)
+
+		if page.NextToken == "" {
+			return items, nil
+		}
+		listDatabaseItemsRequest.NextToken = page.NextToken
+	}
+}
+
+// UpdateDatabase - Updates the mutable settings of a database
+func (c *Client) UpdateDatabase(updateDatabaseRequest UpdateDatabaseRequest, database_id string) (*CreateDatabaseResponse, error) {
+	rb, err := json.Marshal(updateDatabaseRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", fmt.Sprintf("%s/database/%s", c.HostURL, database_id), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	database := CreateDatabaseResponse{}
+	err = json.Unmarshal(body, &database)
+	if err != nil {
+		return nil, err
+	}
+
+	return &database, nil
+}
+
 // DeleteDatabase - Deletes a database
 func (c *Client) DeleteDatabase(database_id string) error {
 	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/database/%s", c.HostURL, database_id), nil)
@@ -172,14 +421,305 @@ func (c *Client) DeleteDatabase(database_id string) error {
 	return nil
 }
 
+// CreateDatabaseCredential - Issues a short-lived credential for a database
+func (c *Client) CreateDatabaseCredential(createDatabaseCredentialRequest CreateDatabaseCredentialRequest, database_id string) (*DatabaseCredential, error) {
+	rb, err := json.Marshal(createDatabaseCredentialRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/database/%s/credentials", c.HostURL, database_id), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	credential := DatabaseCredential{}
+	err = json.Unmarshal(body, &credential)
+	if err != nil {
+		return nil, err
+	}
+
+	return &credential, nil
+}
+
+// ListDatabaseCredentials - Lists the active credentials of a database, without their keys
+func (c *Client) ListDatabaseCredentials(database_id string) ([]DatabaseCredential, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/database/%s/credentials", c.HostURL, database_id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	credentials := ListDatabaseCredentialsResponse{}
+	err = json.Unmarshal(body, &credentials)
+	if err != nil {
+		return nil, err
+	}
+
+	return credentials.Credentials, nil
+}
+
+// RenewDatabaseCredential - Extends the lifetime of a database credential
+func (c *Client) RenewDatabaseCredential(database_id, credential_id string) (*DatabaseCredential, error) {
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/database/%s/credentials/%s/renew", c.HostURL, database_id, credential_id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	credential := DatabaseCredential{}
+	err = json.Unmarshal(body, &credential)
+	if err != nil {
+		return nil, err
+	}
+
+	return &credential, nil
+}
+
+// RevokeDatabaseCredential - Revokes a database credential
+func (c *Client) RevokeDatabaseCredential(database_id, credential_id string) error {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/database/%s/credentials/%s", c.HostURL, database_id, credential_id), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// CreateDatabaseSnapshot - Starts a point-in-time snapshot of a database
+func (c *Client) CreateDatabaseSnapshot(createDatabaseSnapshotRequest CreateDatabaseSnapshotRequest, database_id string) (*DatabaseSnapshot, error) {
+	rb, err := json.Marshal(createDatabaseSnapshotRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/database/%s/snapshots", c.HostURL, database_id), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := DatabaseSnapshot{}
+	err = json.Unmarshal(body, &snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	return &snapshot, nil
+}
+
+// GetDatabaseSnapshot - Get a single snapshot of a database
+func (c *Client) GetDatabaseSnapshot(database_id, snapshot_id string) (*DatabaseSnapshot, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/database/%s/snapshots/%s", c.HostURL, database_id, snapshot_id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := DatabaseSnapshot{}
+	err = json.Unmarshal(body, &snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	return &snapshot, nil
+}
+
+// ListDatabaseSnapshots - Lists one page of the snapshots of a database
+func (c *Client) ListDatabaseSnapshots(listDatabaseSnapshotsRequest ListDatabaseSnapshotsRequest, database_id string) (*ListDatabaseSnapshotsResponse, error) {
+	query := url.Values{}
+	if listDatabaseSnapshotsRequest.NextToken != "" {
+		query.Set("next_token", listDatabaseSnapshotsRequest.NextToken)
+	}
+	if listDatabaseSnapshotsRequest.Limit > 0 {
+		query.Set("limit", strconv.FormatInt(listDatabaseSnapshotsRequest.Limit, 10))
+	}
+
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/database/%s/snapshots", c.HostURL, database_id), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.URL.RawQuery = query.Encode()
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots := ListDatabaseSnapshotsResponse{}
+	err = json.Unmarshal(body, &snapshots)
+	if err != nil {
+		return nil, err
+	}
+
+	return &snapshots, nil
+}
+
+// ListAllDatabaseSnapshots - Lists every snapshot of a database, following pagination
+func (c *Client) ListAllDatabaseSnapshots(database_id string) ([]DatabaseSnapshot, error) {
+	var snapshots []DatabaseSnapshot
+	listDatabaseSnapshotsRequest := ListDatabaseSnapshotsRequest{}
+
+	for {
+		page, err := c.ListDatabaseSnapshots(listDatabaseSnapshotsRequest, database_id)
+		if err != nil {
+			return nil, err
+		}
+
+		snapshots = append(snapshots, page.Snapshots...)
+
+		if page.NextToken == "" {
+			return snapshots, nil
+		}
+		listDatabaseSnapshotsRequest.NextToken = page.NextToken
+	}
+}
+
+// UpdateDatabaseSnapshot - Updates the description and retention of a snapshot
+func (c *Client) UpdateDatabaseSnapshot(updateDatabaseSnapshotRequest UpdateDatabaseSnapshotRequest, database_id, snapshot_id string) (*DatabaseSnapshot, error) {
+	rb, err := json.Marshal(updateDatabaseSnapshotRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", fmt.Sprintf("%s/database/%s/snapshots/%s", c.HostURL, database_id, snapshot_id), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := DatabaseSnapshot{}
+	err = json.Unmarshal(body, &snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	return &snapshot, nil
+}
+
+// DeleteDatabaseSnapshot - Deletes a snapshot of a database
+func (c *Client) DeleteDatabaseSnapshot(database_id, snapshot_id string) error {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/database/%s/snapshots/%s", c.HostURL, database_id, snapshot_id), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// RestoreDatabase - Starts restoring a snapshot of a database, in place or into another database
+func (c *Client) RestoreDatabase(restoreDatabaseRequest RestoreDatabaseRequest, database_id string) (*DatabaseRestore, error) {
+	rb, err := json.Marshal(restoreDatabaseRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/database/%s/restores", c.HostURL, database_id), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	restore := DatabaseRestore{}
+	err = json.Unmarshal(body, &restore)
+	if err != nil {
+		return nil, err
+	}
+
+	return &restore, nil
+}
+
+// GetDatabaseRestore - Get the progress of a restore
+func (c *Client) GetDatabaseRestore(database_id, restore_id string) (*DatabaseRestore, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/database/%s/restores/%s", c.HostURL, database_id, restore_id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	restore := DatabaseRestore{}
+	err = json.Unmarshal(body, &restore)
+	if err != nil {
+		return nil, err
+	}
+
+	return &restore, nil
+}
+
 // Database -
 type Database struct {
-	Id   string `json:"id"`
-	Name string `json:"name"`
+	Id   string            `json:"id"`
+	Name string            `json:"name"`
+	Tags map[string]string `json:"tags,omitempty"`
 }
 
 type CreateDatabaseRequest struct {
-	Name string `json:"name"`
+	Name               string            `json:"name"`
+	DeletionProtection bool              `json:"deletion_protection"`
+	Tags               map[string]string `json:"tags,omitempty"`
+	Tier               string            `json:"tier,omitempty"`
+	StorageGB          int64             `json:"storage_gb,omitempty"`
+	MaxItems           int64             `json:"max_items,omitempty"`
+	EngineVersion      string            `json:"engine_version,omitempty"`
+}
+
+// CloneDatabaseRequest - Settings of the new database; SnapshotId selects a
+// snapshot of the source instead of its current contents.
+type CloneDatabaseRequest struct {
+	CreateDatabaseRequest
+	SnapshotId string `json:"snapshot_id,omitempty"`
+}
+
+type UpdateDatabaseRequest struct {
+	Name               string            `json:"name"`
+	DeletionProtection bool              `json:"deletion_protection"`
+	Tags               map[string]string `json:"tags"`
+	Tier               string            `json:"tier,omitempty"`
+	StorageGB          int64             `json:"storage_gb,omitempty"`
+	MaxItems           int64             `json:"max_items,omitempty"`
 }
 
 type GetDatabaseRequest struct {
@@ -193,12 +733,33 @@ type GetDatabaseResponse struct {
 
 // type ListDatabasesResponse []Database
 
+type ListDatabasesRequest struct {
+	NamePrefix string
+	NextToken  string
+	Limit      int64
+}
+
 type ListDatabasesResponse struct {
 	Databases []Database `json:"databases"`
+	// NextToken is empty on the last page.
+	NextToken string `json:"next_token,omitempty"`
 }
 type CreateDatabaseResponse struct {
 	Id   string `json:"id"`
 	Name string `json:"name"`
+	// DeletionProtection is nil when the service does not support the flag.
+	DeletionProtection *bool             `json:"deletion_protection,omitempty"`
+	Tags               map[string]string `json:"tags,omitempty"`
+	Tier               string            `json:"tier"`
+	StorageGB          int64             `json:"storage_gb"`
+	MaxItems           int64             `json:"max_items"`
+	EngineVersion      string            `json:"engine_version"`
+	CreatedAt          string            `json:"created_at"`
+	UpdatedAt          string            `json:"updated_at"`
+	// Status is empty when the service does not report it; treat that as available.
+	Status           string `json:"status,omitempty"`
+	SourceDatabaseId string `json:"source_database_id,omitempty"`
+	SourceSnapshotId string `json:"source_snapshot_id,omitempty"`
 }
 
 // OrderItem -
@@ -214,7 +775,97 @@ type CreateDatabaseItemRequest struct {
 
 type CreateDatabaseItemResponse []DatabaseItem
 
+type ListDatabaseItemsRequest struct {
+	Prefix    string
+	NextToken string
+	Limit     int64
+}
+
+type ListDatabaseItemsResponse struct {
+	Items []DatabaseItem `json:"items"`
+	// NextToken is empty on the last page.
+	NextToken string `json:"next_token,omitempty"`
+}
+
+type DeleteDatabaseItemsRequest struct {
+	Keys []string `json:"keys"`
+}
+
+type DeleteDatabaseItemsResponse struct {
+	Deleted int64 `json:"deleted"`
+}
+
 // type CreateDatabaseItemResponse struct {
 // 	Key   string `json:"key"`
 // 	Value string `json:"value"`
 // }
+
+type CreateDatabaseCredentialRequest struct {
+	Scope      string `json:"scope"`
+	TTLSeconds int64  `json:"ttl_seconds,omitempty"`
+}
+
+// DatabaseCredential -
+type DatabaseCredential struct {
+	Id         string `json:"id"`
+	DatabaseId string `json:"database_id"`
+	Scope      string `json:"scope"`
+	Key        string `json:"key"`
+	ExpiresAt  string `json:"expires_at"`
+}
+
+type ListDatabaseCredentialsResponse struct {
+	Credentials []DatabaseCredential `json:"credentials"`
+}
+
+type CreateDatabaseSnapshotRequest struct {
+	Description   string `json:"description,omitempty"`
+	RetentionDays int64  `json:"retention_days,omitempty"`
+}
+
+type UpdateDatabaseSnapshotRequest struct {
+	Description   string `json:"description"`
+	RetentionDays int64  `json:"retention_days,omitempty"`
+}
+
+type ListDatabaseSnapshotsRequest struct {
+	NextToken string
+	Limit     int64
+}
+
+type ListDatabaseSnapshotsResponse struct {
+	Snapshots []DatabaseSnapshot `json:"snapshots"`
+	// NextToken is empty on the last page.
+	NextToken string `json:"next_token,omitempty"`
+}
+
+// DatabaseSnapshot -
+type DatabaseSnapshot struct {
+	Id            string `json:"id"`
+	DatabaseId    string `json:"database_id"`
+	Description   string `json:"description"`
+	Status        string `json:"status"`
+	RetentionDays int64  `json:"retention_days"`
+	CreatedAt     string `json:"created_at"`
+	ExpiresAt     string `json:"expires_at"`
+}
+
+// RestoreDatabaseRequest - Restores in place unless TargetDatabaseId or
+// TargetName is set; TargetName creates a new database.
+type RestoreDatabaseRequest struct {
+	SnapshotId       string `json:"snapshot_id"`
+	TargetDatabaseId string `json:"target_database_id,omitempty"`
+	TargetName       string `json:"target_name,omitempty"`
+}
+
+// DatabaseRestore -
+type DatabaseRestore struct {
+	Id               string `json:"id"`
+	DatabaseId       string `json:"database_id"`
+	SnapshotId       string `json:"snapshot_id"`
+	TargetDatabaseId string `json:"target_database_id"`
+	Status           string `json:"status"`
+	ProgressPercent  int64  `json:"progress_percent"`
+	ItemsRestored    int64  `json:"items_restored"`
+	Error            string `json:"error,omitempty"`
+}
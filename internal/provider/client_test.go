@@ -0,0 +1,671 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// testClientAPIKey is the API key the client test server expects.
+const testClientAPIKey = "test-api-key"
+
+// clientExchange is one request the client is expected to send and the
+// recorded response it gets back. Fixtures are read from testdata/client.
+type clientExchange struct {
+	method string
+	// path is the escaped URL path.
+	path            string
+	query           string
+	requestFixture  string
+	responseFixture string
+	// status defaults to 200.
+	status int
+}
+
+// readClientFixture reads a fixture from testdata/client.
+func readClientFixture(t *testing.T, name string) []byte {
+	t.Helper()
+
+	data, err := os.ReadFile(filepath.Join("testdata", "client", name))
+	if err != nil {
+		t.Fatalf("reading fixture: %s", err)
+	}
+
+	return data
+}
+
+// testClientServer serves exchanges in order and fails the test when the
+// client sends a request that does not match the next exchange.
+func testClientServer(t *testing.T, exchanges []clientExchange) *Client {
+	t.Helper()
+
+	next := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if next >= len(exchanges) {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			http.Error(w, "unexpected request", http.StatusInternalServerError)
+			return
+		}
+		exchange := exchanges[next]
+		next++
+
+		if r.Method != exchange.method {
+			t.Errorf("request %d: got method %s, want %s", next, r.Method, exchange.method)
+		}
+		if r.URL.EscapedPath() != exchange.path {
+			t.Errorf("request %d: got path %s, want %s", next, r.URL.EscapedPath(), exchange.path)
+		}
+		if r.URL.RawQuery != exchange.query {
+			t.Errorf("request %d: got query %q, want %q", next, r.URL.RawQuery, exchange.query)
+		}
+		if got := r.Header.Get("api_key"); got != testClientAPIKey {
+			t.Errorf("request %d: got api_key header %q, want %q", next, got, testClientAPIKey)
+		}
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Errorf("request %d: reading body: %s", next, err)
+		}
+		if exchange.requestFixture == "" {
+			if len(body) != 0 {
+				t.Errorf("request %d: got body %s, want none", next, body)
+			}
+		} else {
+			var got, want any
+			if err := json.Unmarshal(body, &got); err != nil {
+				t.Errorf("request %d: decoding body %s: %s", next, body, err)
+			}
+			if err := json.Unmarshal(readClientFixture(t, exchange.requestFixture), &want); err != nil {
+				t.Errorf("request %d: decoding fixture: %s", next, err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("request %d: got body %s, want %s", next, body, readClientFixture(t, exchange.requestFixture))
+			}
+		}
+
+		status := exchange.status
+		if status == 0 {
+			status = http.StatusOK
+		}
+		w.WriteHeader(status)
+		if exchange.responseFixture != "" {
+			_, _ = w.Write(readClientFixture(t, exchange.responseFixture))
+		}
+	}))
+	t.Cleanup(func() {
+		server.Close()
+		if next != len(exchanges) {
+			t.Errorf("got %d requests, want %d", next, len(exchanges))
+		}
+	})
+
+	return &Client{HostURL: server.URL, HTTPClient: server.Client(), Token: testClientAPIKey}
+}
+
+func TestClient(t *testing.T) {
+	protected := true
+
+	database := &CreateDatabaseResponse{
+		Id:                 "db-0123abcd",
+		Name:               "orders",
+		DeletionProtection: &protected,
+		Tags:               map[string]string{"environment": "prod"},
+		Tier:               "premium",
+		StorageGB:          250,
+		MaxItems:           5000000,
+		EngineVersion:      "2.1",
+		CreatedAt:          "2025-05-01T08:30:00Z",
+		UpdatedAt:          "2025-06-01T12:00:00Z",
+		Status:             "available",
+	}
+	snapshot := DatabaseSnapshot{
+		Id:            "snap-0001",
+		DatabaseId:    "db-0123abcd",
+		Description:   "nightly",
+		Status:        "available",
+		RetentionDays: 7,
+		CreatedAt:     "2025-06-01T00:00:00Z",
+		ExpiresAt:     "2025-06-08T00:00:00Z",
+	}
+	credential := &DatabaseCredential{
+		Id:         "cred-0001",
+		DatabaseId: "db-0123abcd",
+		Scope:      "read",
+		Key:        "k3y-s3cr3t",
+		ExpiresAt:  "2025-06-01T13:00:00Z",
+	}
+	restore := &DatabaseRestore{
+		Id:               "restore-0001",
+		DatabaseId:       "db-0123abcd",
+		SnapshotId:       "snap-0001",
+		TargetDatabaseId: "db-89abcdef",
+		Status:           "running",
+		ProgressPercent:  40,
+		ItemsRestored:    400,
+	}
+
+	cases := map[string]struct {
+		exchanges []clientExchange
+		call      func(c *Client) (any, error)
+		expect    any
+	}{
+		"CreateDatabaseItem": {
+			exchanges: []clientExchange{{
+				method:          "POST",
+				path:            "/database/db-0123abcd/items",
+				requestFixture:  "create_database_item.request.json",
+				responseFixture: "create_database_item.response.json",
+			}},
+			call: func(c *Client) (any, error) {
+				return c.CreateDatabaseItem(CreateDatabaseItemRequest{Key: "users/alice", Value: "alice"}, "db-0123abcd")
+			},
+			expect: &CreateDatabaseItemResponse{{Key: "users/alice", Value: "alice"}},
+		},
+		"GetDatabaseItem": {
+			exchanges: []clientExchange{{
+				method:          "GET",
+				path:            "/database/db-0123abcd/items/users%2Falice",
+				responseFixture: "get_database_item.response.json",
+			}},
+			call: func(c *Client) (any, error) {
+				return c.GetDatabaseItem("db-0123abcd", "users/alice")
+			},
+			expect: &DatabaseItem{Key: "users/alice", Value: "alice"},
+		},
+		"DeleteDatabaseItem": {
+			exchanges: []clientExchange{{
+				method: "DELETE",
+				path:   "/database/db-0123abcd/items/users%2Falice",
+			}},
+			call: func(c *Client) (any, error) {
+				return nil, c.DeleteDatabaseItem("db-0123abcd", "users/alice")
+			},
+		},
+		"DeleteDatabaseItems": {
+			exchanges: []clientExchange{{
+				method:          "POST",
+				path:            "/database/db-0123abcd/delete-items",
+				requestFixture:  "delete_database_items.request.json",
+				responseFixture: "delete_database_items.response.json",
+			}},
+			call: func(c *Client) (any, error) {
+				return c.DeleteDatabaseItems(DeleteDatabaseItemsRequest{Keys: []string{"users/alice", "users/bob"}}, "db-0123abcd")
+			},
+			expect: &DeleteDatabaseItemsResponse{Deleted: 2},
+		},
+		"CreateDatabase": {
+			exchanges: []clientExchange{{
+				method:          "POST",
+				path:            "/database",
+				requestFixture:  "create_database.request.json",
+				responseFixture: "database.response.json",
+			}},
+			call: func(c *Client) (any, error) {
+				return c.CreateDatabase(CreateDatabaseRequest{
+					Name:               "orders",
+					DeletionProtection: true,
+					Tags:               map[string]string{"environment": "prod"},
+					Tier:               "premium",
+					StorageGB:          250,
+					MaxItems:           5000000,
+					EngineVersion:      "2.1",
+				})
+			},
+			expect: database,
+		},
+		"CreateDatabase omits unset settings": {
+			exchanges: []clientExchange{{
+				method:          "POST",
+				path:            "/database",
+				requestFixture:  "create_database_minimal.request.json",
+				responseFixture: "database.response.json",
+			}},
+			call: func(c *Client) (any, error) {
+				return c.CreateDatabase(CreateDatabaseRequest{Name: "orders"})
+			},
+			expect: database,
+		},
+		"CloneDatabase": {
+			exchanges: []clientExchange{{
+				method:          "POST",
+				path:            "/database/db-0123abcd/clone",
+				requestFixture:  "clone_database.request.json",
+				responseFixture: "clone_database.response.json",
+			}},
+			call: func(c *Client) (any, error) {
+				return c.CloneDatabase(CloneDatabaseRequest{
+					CreateDatabaseRequest: CreateDatabaseRequest{Name: "orders-copy"},
+					SnapshotId:            "snap-0001",
+				}, "db-0123abcd")
+			},
+			expect: &CreateDatabaseResponse{
+				Id:                 "db-4567efgh",
+				Name:               "orders-copy",
+				DeletionProtection: &protected,
+				Tags:               map[string]string{"environment": "prod"},
+				Tier:               "premium",
+				StorageGB:          250,
+				MaxItems:           5000000,
+				EngineVersion:      "2.1",
+				CreatedAt:          "2025-05-01T08:30:00Z",
+				UpdatedAt:          "2025-06-01T12:00:00Z",
+				Status:             "creating",
+				SourceDatabaseId:   "db-0123abcd",
+				SourceSnapshotId:   "snap-0001",
+			},
+		},
+		"GetDatabase": {
+			exchanges: []clientExchange{{
+				method:          "GET",
+				path:            "/database/db-0123abcd",
+				responseFixture: "database.response.json",
+			}},
+			call: func(c *Client) (any, error) {
+				return c.GetDatabase("db-0123abcd")
+			},
+			expect: database,
+		},
+		"ListDatabases": {
+			exchanges: []clientExchange{{
+				method:          "GET",
+				path:            "/database",
+				query:           "limit=1&name_prefix=ord&next_token=page-1",
+				responseFixture: "list_databases_page1.response.json",
+			}},
+			call: func(c *Client) (any, error) {
+				return c.ListDatabases(ListDatabasesRequest{NamePrefix: "ord", NextToken: "page-1", Limit: 1})
+			},
+			expect: &ListDatabasesResponse{
+				Databases: []Database{{Id: "db-0123abcd", Name: "orders", Tags: map[string]string{"environment": "prod"}}},
+				NextToken: "page-2",
+			},
+		},
+		"ListAllDatabases": {
+			exchanges: []clientExchange{
+				{
+					method:          "GET",
+					path:            "/database",
+					query:           "name_prefix=ord",
+					responseFixture: "list_databases_page1.response.json",
+				},
+				{
+					method:          "GET",
+					path:            "/database",
+					query:           "name_prefix=ord&next_token=page-2",
+					responseFixture: "list_databases_page2.response.json",
+				},
+			},
+			call: func(c *Client) (any, error) {
+				return c.ListAllDatabases(ListDatabasesRequest{NamePrefix: "ord"})
+			},
+			expect: []Database{
+				{Id: "db-0123abcd", Name: "orders", Tags: map[string]string{"environment": "prod"}},
+				{Id: "db-4567efgh", Name: "orders-copy"},
+			},
+		},
+		"ListDatabaseItems": {
+			exchanges: []clientExchange{{
+				method:          "GET",
+				path:            "/database/db-0123abcd/items",
+				query:           "limit=1&prefix=users%2F",
+				responseFixture: "list_database_items_page1.response.json",
+			}},
+			call: func(c *Client) (any, error) {
+				return c.ListDatabaseItems(ListDatabaseItemsRequest{Prefix: "users/", Limit: 1}, "db-0123abcd")
+			},
+			expect: &ListDatabaseItemsResponse{
+				Items:     []DatabaseItem{{Key: "users/alice", Value: "alice"}},
+				NextToken: "page-2",
+			},
+		},
+		"ListAllDatabaseItems": {
+			exchanges: []clientExchange{
+				{
+					method:          "GET",
+					path:            "/database/db-0123abcd/items",
+					query:           "prefix=users%2F",
+					responseFixture: "list_database_items_page1.response.json",
+				},
+				{
+					method:          "GET",
+					path:            "/database/db-0123abcd/items",
+					query:           "next_token=page-2&prefix=users%2F",
+					responseFixture: "list_database_items_page2.response.json",
+				},
+			},
+			call: func(c *Client) (any, error) {
+				return c.ListAllDatabaseItems("users/", "db-0123abcd")
+			},
+			expect: []DatabaseItem{
+				{Key: "users/alice", Value: "alice"},
+				{Key: "users/bob", Value: "bob"},
+			},
+		},
+		"UpdateDatabase": {
+			exchanges: []clientExchange{{
+				method:          "PATCH",
+				path:            "/database/db-0123abcd",
+				requestFixture:  "update_database.request.json",
+				responseFixture: "database.response.json",
+			}},
+			call: func(c *Client) (any, error) {
+				return c.UpdateDatabase(UpdateDatabaseRequest{
+					Name:      "orders",
+					Tags:      map[string]string{"environment": "prod"},
+					Tier:      "premium",
+					StorageGB: 500,
+				}, "db-0123abcd")
+			},
+			expect: database,
+		},
+		"DeleteDatabase": {
+			exchanges: []clientExchange{{
+				method: "DELETE",
+				path:   "/database/db-0123abcd",
+			}},
+			call: func(c *Client) (any, error) {
+				return nil, c.DeleteDatabase("db-0123abcd")
+			},
+		},
+		"CreateDatabaseCredential": {
+			exchanges: []clientExchange{{
+				method:          "POST",
+				path:            "/database/db-0123abcd/credentials",
+				requestFixture:  "create_database_credential.request.json",
+				responseFixture: "database_credential.response.json",
+			}},
+			call: func(c *Client) (any, error) {
+				return c.CreateDatabaseCredential(CreateDatabaseCredentialRequest{Scope: "read", TTLSeconds: 3600}, "db-0123abcd")
+			},
+			expect: credential,
+		},
+		"ListDatabaseCredentials": {
+			exchanges: []clientExchange{{
+				method:          "GET",
+				path:            "/database/db-0123abcd/credentials",
+				responseFixture: "list_database_credentials.response.json",
+			}},
+			call: func(c *Client) (any, error) {
+				return c.ListDatabaseCredentials("db-0123abcd")
+			},
+			expect: []DatabaseCredential{{
+				Id:         "cred-0001",
+				DatabaseId: "db-0123abcd",
+				Scope:      "read",
+				ExpiresAt:  "2025-06-01T13:00:00Z",
+			}},
+		},
+		"RenewDatabaseCredential": {
+			exchanges: []clientExchange{{
+				method:          "POST",
+				path:            "/database/db-0123abcd/credentials/cred-0001/renew",
+				responseFixture: "database_credential.response.json",
+			}},
+			call: func(c *Client) (any, error) {
+				return c.RenewDatabaseCredential("db-0123abcd", "cred-0001")
+			},
+			expect: credential,
+		},
+		"RevokeDatabaseCredential": {
+			exchanges: []clientExchange{{
+				method: "DELETE",
+				path:   "/database/db-0123abcd/credentials/cred-0001",
+			}},
+			call: func(c *Client) (any, error) {
+				return nil, c.RevokeDatabaseCredential("db-0123abcd", "cred-0001")
+			},
+		},
+		"CreateDatabaseSnapshot": {
+			exchanges: []clientExchange{{
+				method:          "POST",
+				path:            "/database/db-0123abcd/snapshots",
+				requestFixture:  "create_database_snapshot.request.json",
+				responseFixture: "database_snapshot.response.json",
+			}},
+			call: func(c *Client) (any, error) {
+				return c.CreateDatabaseSnapshot(CreateDatabaseSnapshotRequest{Description: "nightly", RetentionDays: 7}, "db-0123abcd")
+			},
+			expect: &snapshot,
+		},
+		"GetDatabaseSnapshot": {
+			exchanges: []clientExchange{{
+				method:          "GET",
+				path:            "/database/db-0123abcd/snapshots/snap-0001",
+				responseFixture: "database_snapshot.response.json",
+			}},
+			call: func(c *Client) (any, error) {
+				return c.GetDatabaseSnapshot("db-0123abcd", "snap-0001")
+			},
+			expect: &snapshot,
+		},
+		"ListDatabaseSnapshots": {
+			exchanges: []clientExchange{{
+				method:          "GET",
+				path:            "/database/db-0123abcd/snapshots",
+				query:           "limit=1",
+				responseFixture: "list_database_snapshots_page1.response.json",
+			}},
+			call: func(c *Client) (any, error) {
+				return c.ListDatabaseSnapshots(ListDatabaseSnapshotsRequest{Limit: 1}, "db-0123abcd")
+			},
+			expect: &ListDatabaseSnapshotsResponse{
+				Snapshots: []DatabaseSnapshot{snapshot},
+				NextToken: "page-2",
+			},
+		},
+		"ListAllDatabaseSnapshots": {
+			exchanges: []clientExchange{
+				{
+					method:          "GET",
+					path:            "/database/db-0123abcd/snapshots",
+					responseFixture: "list_database_snapshots_page1.response.json",
+				},
+				{
+					method:          "GET",
+					path:            "/database/db-0123abcd/snapshots",
+					query:           "next_token=page-2",
+					responseFixture: "list_database_snapshots_page2.response.json",
+				},
+			},
+			call: func(c *Client) (any, error) {
+				return c.ListAllDatabaseSnapshots("db-0123abcd")
+			},
+			expect: []DatabaseSnapshot{
+				snapshot,
+				{
+					Id:            "snap-0002",
+					DatabaseId:    "db-0123abcd",
+					Description:   "weekly",
+					Status:        "creating",
+					RetentionDays: 7,
+					CreatedAt:     "2025-06-02T00:00:00Z",
+					ExpiresAt:     "2025-06-09T00:00:00Z",
+				},
+			},
+		},
+		"UpdateDatabaseSnapshot": {
+			exchanges: []clientExchange{{
+				method:          "PATCH",
+				path:            "/database/db-0123abcd/snapshots/snap-0001",
+				requestFixture:  "update_database_snapshot.request.json",
+				responseFixture: "database_snapshot.response.json",
+			}},
+			call: func(c *Client) (any, error) {
+				return c.UpdateDatabaseSnapshot(UpdateDatabaseSnapshotRequest{RetentionDays: 30}, "db-0123abcd", "snap-0001")
+			},
+			expect: &snapshot,
+		},
+		"DeleteDatabaseSnapshot": {
+			exchanges: []clientExchange{{
+				method: "DELETE",
+				path:   "/database/db-0123abcd/snapshots/snap-0001",
+			}},
+			call: func(c *Client) (any, error) {
+				return nil, c.DeleteDatabaseSnapshot("db-0123abcd", "snap-0001")
+			},
+		},
+		"RestoreDatabase": {
+			exchanges: []clientExchange{{
+				method:          "POST",
+				path:            "/database/db-0123abcd/restores",
+				requestFixture:  "restore_database.request.json",
+				responseFixture: "database_restore.response.json",
+			}},
+			call: func(c *Client) (any, error) {
+				return c.RestoreDatabase(RestoreDatabaseRequest{SnapshotId: "snap-0001", TargetName: "orders-restored"}, "db-0123abcd")
+			},
+			expect: restore,
+		},
+		"GetDatabaseRestore": {
+			exchanges: []clientExchange{{
+				method:          "GET",
+				path:            "/database/db-0123abcd/restores/restore-0001",
+				responseFixture: "database_restore.response.json",
+			}},
+			call: func(c *Client) (any, error) {
+				return c.GetDatabaseRestore("db-0123abcd", "restore-0001")
+			},
+			expect: restore,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			client := testClientServer(t, tc.exchanges)
+
+			got, err := tc.call(client)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if tc.expect == nil {
+				return
+			}
+			if !reflect.DeepEqual(got, tc.expect) {
+				t.Errorf("got %#v, want %#v", got, tc.expect)
+			}
+		})
+	}
+}
+
+func TestClient_Errors(t *testing.T) {
+	cases := map[string]struct {
+		status         int
+		body           string
+		expectAPIError *APIError
+		expectNotFound bool
+	}{
+		"not found": {
+			status:         http.StatusNotFound,
+			body:           `{"error": "database db-0123abcd not found"}`,
+			expectAPIError: &APIError{StatusCode: http.StatusNotFound, Body: `{"error": "database db-0123abcd not found"}`},
+			expectNotFound: true,
+		},
+		"conflict": {
+			status:         http.StatusConflict,
+			body:           `{"error": "deletion protection is enabled"}`,
+			expectAPIError: &APIError{StatusCode: http.StatusConflict, Body: `{"error": "deletion protection is enabled"}`},
+		},
+		"server error": {
+			status:         http.StatusInternalServerError,
+			body:           "internal error",
+			expectAPIError: &APIError{StatusCode: http.StatusInternalServerError, Body: "internal error"},
+		},
+		"undecodable response": {
+			status: http.StatusOK,
+			body:   "<html>",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tc.status)
+				_, _ = io.WriteString(w, tc.body)
+			}))
+			t.Cleanup(server.Close)
+
+			client := &Client{HostURL: server.URL, HTTPClient: server.Client(), Token: testClientAPIKey}
+
+			_, err := client.GetDatabase("db-0123abcd")
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+
+			var apiErr *APIError
+			if tc.expectAPIError == nil {
+				if errors.As(err, &apiErr) {
+					t.Errorf("got API error %#v, want a decoding error", apiErr)
+				}
+			} else if !errors.As(err, &apiErr) || !reflect.DeepEqual(apiErr, tc.expectAPIError) {
+				t.Errorf("got error %#v, want %#v", err, tc.expectAPIError)
+			}
+
+			if got := IsNotFound(err); got != tc.expectNotFound {
+				t.Errorf("got IsNotFound %t, want %t", got, tc.expectNotFound)
+			}
+		})
+	}
+}
+
+func TestClient_UserAgent(t *testing.T) {
+	cases := map[string]struct {
+		providerVersion  string
+		terraformVersion string
+		extra            string
+		expected         string
+	}{
+		"provider and terraform": {
+			providerVersion:  "1.4.0",
+			terraformVersion: "1.14.0",
+			expected:         "terraform-provider-bobsdiscountcloudco/1.4.0 terraform/1.14.0",
+		},
+		"unknown terraform version": {
+			providerVersion: "dev",
+			expected:        "terraform-provider-bobsdiscountcloudco/dev",
+		},
+		"extra tokens": {
+			providerVersion:  "1.4.0",
+			terraformVersion: "1.14.0",
+			extra:            " bobs-support/2.0 (ticket-1234) ",
+			expected:         "terraform-provider-bobsdiscountcloudco/1.4.0 terraform/1.14.0 bobs-support/2.0 (ticket-1234)",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Setenv(userAgentExtraEnvVar, tc.extra)
+
+			var got string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				got = r.Header.Get("User-Agent")
+				_, _ = w.Write(readClientFixture(t, "database.response.json"))
+			}))
+			t.Cleanup(server.Close)
+
+			client := &Client{
+				HostURL:    server.URL,
+				HTTPClient: server.Client(),
+				Token:      testClientAPIKey,
+				UserAgent:  UserAgent(tc.providerVersion, tc.terraformVersion),
+			}
+
+			if _, err := client.GetDatabase("db-0123abcd"); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != tc.expected {
+				t.Errorf("got User-Agent %q, want %q", got, tc.expected)
+			}
+		})
+	}
+}
@@ -34,6 +34,11 @@ func (d *bdccDataSource) Metadata(_ context.Context, req datasource.MetadataRequ
 func (d *bdccDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"tags": schema.MapAttribute{
+				Description: "Only return databases that have all of these tags.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
 			"databases": schema.ListNestedAttribute{
 				Computed: true,
 				NestedObject: schema.NestedAttributeObject{
@@ -44,6 +49,10 @@ func (d *bdccDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, r
 						"name": schema.StringAttribute{
 							Computed: true,
 						},
+						"tags": schema.MapAttribute{
+							ElementType: types.StringType,
+							Computed:    true,
+						},
 					},
 				},
 			},
@@ -53,6 +62,7 @@ func (d *bdccDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, r
 
 // coffeesDataSourceModel maps the data source schema data.
 type bdccDataSourceModel struct {
+	Tags      types.Map       `tfsdk:"tags"`
 	Databases []databaseModel `tfsdk:"databases"`
 }
 
@@ -60,13 +70,25 @@ type bdccDataSourceModel struct {
 type databaseModel struct {
 	Id   types.String `tfsdk:"id"`
 	Name types.String `tfsdk:"name"`
+	Tags types.Map    `tfsdk:"tags"`
 }
 
 // Read refreshes the Terraform state with the latest data.
 func (d *bdccDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var state bdccDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, diags := tagsFromMap(ctx, state.Tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	databases, err := d.client.ListDatabases()
+	databases, err := d.client.ListAllDatabases(ListDatabasesRequest{})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read HashiCups Coffees",
@@ -76,17 +98,25 @@ func (d *bdccDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 	}
 
 	// Map response body to model
-	for _, database := range databases.Databases {
+	for _, database := range databases {
+		if !tagsMatch(filter, database.Tags) {
+			continue
+		}
+
+		tags, diags := tagsToMap(ctx, database.Tags)
+		resp.Diagnostics.Append(diags...)
+
 		databaseState := databaseModel{
 			Id:   types.StringValue(database.Id),
 			Name: types.StringValue(database.Name),
+			Tags: tags,
 		}
 
 		state.Databases = append(state.Databases, databaseState)
 	}
 
 	// Set state
-	diags := resp.State.Set(ctx, &state)
+	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
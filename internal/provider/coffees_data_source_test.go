@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccBdccDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBdccDataSourceConfig(),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.bobsdiscountcloudco_databases.test",
+						tfjsonpath.New("databases"),
+						knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.ObjectPartial(map[string]knownvalue.Check{
+								"name": knownvalue.StringExact("tf-acc-databases-a"),
+								"tags": knownvalue.MapExact(map[string]knownvalue.Check{
+									"suite": knownvalue.StringExact("tf-acc-databases-a"),
+								}),
+							}),
+						}),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccBdccDataSourceConfig() string {
+	return testAccProviderConfig() + `
+resource "bobsdiscountcloudco_database" "a" {
+  name                = "tf-acc-databases-a"
+  deletion_protection = false
+
+  tags = {
+    suite = "tf-acc-databases-a"
+  }
+}
+
+resource "bobsdiscountcloudco_database" "b" {
+  name                = "tf-acc-databases-b"
+  deletion_protection = false
+
+  tags = {
+    suite = "tf-acc-databases-b"
+  }
+}
+
+data "bobsdiscountcloudco_databases" "test" {
+  tags = {
+    suite = "tf-acc-databases-a"
+  }
+
+  depends_on = [
+    bobsdiscountcloudco_database.a,
+    bobsdiscountcloudco_database.b,
+  ]
+}
+`
+}
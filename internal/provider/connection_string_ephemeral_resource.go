@@ -0,0 +1,188 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// connectionStringScheme is the URI scheme understood by Bob's client libraries.
+const connectionStringScheme = "bdcc"
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ ephemeral.EphemeralResource              = &connectionStringEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &connectionStringEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithRenew     = &connectionStringEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithClose     = &connectionStringEphemeralResource{}
+)
+
+// NewConnectionStringEphemeralResource is a helper function to simplify the provider implementation.
+func NewConnectionStringEphemeralResource() ephemeral.EphemeralResource {
+	return &connectionStringEphemeralResource{}
+}
+
+// connectionStringEphemeralResource is the ephemeral resource implementation.
+type connectionStringEphemeralResource struct {
+	client *Client
+}
+
+// connectionStringEphemeralResourceModel maps the ephemeral resource schema data.
+type connectionStringEphemeralResourceModel struct {
+	DatabaseId types.String `tfsdk:"database_id"`
+	Scope      types.String `tfsdk:"scope"`
+	URI        types.String `tfsdk:"uri"`
+	DSN        types.Map    `tfsdk:"dsn"`
+}
+
+// Metadata returns the ephemeral resource type name.
+func (r *connectionStringEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_connection_string"
+}
+
+// Schema defines the schema for the ephemeral resource.
+func (r *connectionStringEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Builds a connection URI for a database from the provider's configured host and a freshly issued credential. The credential is revoked once Terraform is done, and is never stored in state.",
+		Attributes: map[string]schema.Attribute{
+			"database_id": schema.StringAttribute{
+				Description: "ID of the database to connect to.",
+				Required:    true,
+			},
+			"scope": schema.StringAttribute{
+				Description: "Permission scope of the embedded credential. One of `read`, `write` or `admin`. Defaults to `read`.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("read", "write", "admin"),
+				},
+			},
+			"uri": schema.StringAttribute{
+				Description: "Connection URI in the form `bdcc://<user>:<password>@<host>/<database_id>?scope=<scope>`, with every component escaped.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"dsn": schema.MapAttribute{
+				Description: "Connection parameters as a map with the keys `host`, `database`, `user`, `password` and `scope`.",
+				ElementType: types.StringType,
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the ephemeral resource.
+func (r *connectionStringEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *provider.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Open issues a credential and builds the connection string from it.
+func (r *connectionStringEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data connectionStringEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scope := "read"
+	if !data.Scope.IsNull() {
+		scope = data.Scope.ValueString()
+	}
+
+	host, err := url.Parse(r.client.HostURL)
+	if err != nil || host.Host == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Provider Host",
+			fmt.Sprintf("Could not determine the database host from the provider host %q.", r.client.HostURL),
+		)
+		return
+	}
+
+	credential, err := r.client.CreateDatabaseCredential(CreateDatabaseCredentialRequest{
+		Scope: scope,
+	}, data.DatabaseId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Issuing Database Credential",
+			"Could not issue credential for database ID "+data.DatabaseId.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	data.URI = types.StringValue(buildConnectionURI(host.Host, data.DatabaseId.ValueString(), credential.Id, credential.Key, scope))
+
+	dsn, diags := types.MapValueFrom(ctx, types.StringType, map[string]string{
+		"host":     host.Host,
+		"database": data.DatabaseId.ValueString(),
+		"user":     credential.Id,
+		"password": credential.Key,
+		"scope":    scope,
+	})
+	resp.Diagnostics.Append(diags...)
+	data.DSN = dsn
+
+	if !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+	}
+	if !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(setDatabaseCredentialPrivateData(ctx, resp.Private, data.DatabaseId.ValueString(), credential.Id)...)
+	}
+	if resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(revokeUnusedDatabaseCredential(r.client, data.DatabaseId.ValueString(), credential.Id)...)
+		return
+	}
+
+	resp.RenewAt = credentialRenewAt(credential.ExpiresAt)
+}
+
+// Renew extends the lifetime of the credential embedded in the connection string.
+func (r *connectionStringEphemeralResource) Renew(ctx context.Context, req ephemeral.RenewRequest, resp *ephemeral.RenewResponse) {
+	renewAt, diags := renewDatabaseCredential(ctx, r.client, req.Private)
+	resp.Diagnostics.Append(diags...)
+	resp.RenewAt = renewAt
+}
+
+// Close revokes the credential embedded in the connection string.
+func (r *connectionStringEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	resp.Diagnostics.Append(revokeDatabaseCredential(ctx, r.client, req.Private)...)
+}
+
+// buildConnectionURI assembles an escaped bdcc:// connection URI.
+func buildConnectionURI(host, database_id, user, password, scope string) string {
+	uri := url.URL{
+		Scheme:   connectionStringScheme,
+		User:     url.UserPassword(user, password),
+		Host:     host,
+		Path:     "/" + database_id,
+		RawPath:  "/" + url.PathEscape(database_id),
+		RawQuery: url.Values{"scope": []string{scope}}.Encode(),
+	}
+
+	return uri.String()
+}
@@ -0,0 +1,273 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Conflict policies decide what happens to source items whose key already
+// exists in the target database with a different value.
+const (
+	conflictPolicyOverwrite = "overwrite"
+	conflictPolicySkip      = "skip"
+	conflictPolicyFail      = "fail"
+)
+
+const (
+	// defaultConflictPolicy is used when a copy does not set a policy.
+	defaultConflictPolicy = conflictPolicyFail
+
+	// copyProgressInterval is the number of items written between progress
+	// events.
+	copyProgressInterval = 100
+
+	// maxReportedConflicts is the number of conflicting keys listed when a
+	// copy fails.
+	maxReportedConflicts = 10
+)
+
+// conflictPolicies lists the supported conflict policies.
+func conflictPolicies() []string {
+	return []string{conflictPolicyOverwrite, conflictPolicySkip, conflictPolicyFail}
+}
+
+func NewCopyItemsAction() action.Action {
+	return &copyItemsAction{}
+}
+
+type copyItemsAction struct {
+	client *Client
+}
+
+var (
+	_ action.Action              = (*copyItemsAction)(nil)
+	_ action.ActionWithConfigure = (*copyItemsAction)(nil)
+)
+
+// Configure adds the provider configured client to the action.
+func (a *copyItemsAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *provider.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.client = client
+}
+
+type copyItemsActionModel struct {
+	SourceDatabaseId types.String `tfsdk:"source_database_id"`
+	TargetDatabaseId types.String `tfsdk:"target_database_id"`
+	KeyPrefix        types.String `tfsdk:"key_prefix"`
+	TargetKeyPrefix  types.String `tfsdk:"target_key_prefix"`
+	ConflictPolicy   types.String `tfsdk:"conflict_policy"`
+	DryRun           types.Bool   `tfsdk:"dry_run"`
+}
+
+func (a *copyItemsAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_copy_items_action"
+}
+
+func (a *copyItemsAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Copies items from one database to another, optionally rewriting their keys. Items already in the target database with the same value are left untouched.",
+		Attributes: map[string]schema.Attribute{
+			"source_database_id": schema.StringAttribute{
+				Description: "ID of the database to copy items from.",
+				Required:    true,
+			},
+			"target_database_id": schema.StringAttribute{
+				Description: "ID of the database to copy items to.",
+				Required:    true,
+			},
+			"key_prefix": schema.StringAttribute{
+				Description: "Only copy items whose key starts with this prefix.",
+				Optional:    true,
+			},
+			"target_key_prefix": schema.StringAttribute{
+				Description: "Replaces `key_prefix` in the keys of copied items, or is prepended to them when `key_prefix` is not set. Defaults to keeping keys as they are.",
+				Optional:    true,
+			},
+			"conflict_policy": schema.StringAttribute{
+				Description: "What to do with items whose key already exists in the target database with a different value. `overwrite` replaces them, `skip` keeps the target value and `fail` copies nothing. Defaults to `fail`.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(conflictPolicies()...),
+				},
+			},
+			"dry_run": schema.BoolAttribute{
+				Description: "Only report the items that would be created, updated or skipped, without writing to the target database.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (a *copyItemsAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config copyItemsActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	source_id := config.SourceDatabaseId.ValueString()
+	target_id := config.TargetDatabaseId.ValueString()
+	keyPrefix := config.KeyPrefix.ValueString()
+	targetPrefix := keyPrefix
+	if !config.TargetKeyPrefix.IsNull() {
+		targetPrefix = config.TargetKeyPrefix.ValueString()
+	}
+	policy := defaultConflictPolicy
+	if !config.ConflictPolicy.IsNull() {
+		policy = config.ConflictPolicy.ValueString()
+	}
+
+	sourceItems, err := a.client.ListAllDatabaseItems(keyPrefix, source_id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Database Items",
+			"Could not list items of database ID "+source_id+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Read %d items from database %s", len(sourceItems), source_id),
+	})
+
+	items := make([]DatabaseItem, 0, len(sourceItems))
+	for _, item := range sourceItems {
+		key := targetPrefix + strings.TrimPrefix(item.Key, keyPrefix)
+		if err := validateItemKey(key); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("target_key_prefix"),
+				"Invalid Target Item Key",
+				"Item "+item.Key+" cannot be copied: "+err.Error()+". No items were copied.",
+			)
+			return
+		}
+
+		items = append(items, DatabaseItem{Key: key, Value: item.Value})
+	}
+
+	existing, err := existingItemValues(a.client, target_id, targetPrefix)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Database Items",
+			"Could not list items of database ID "+target_id+": "+err.Error(),
+		)
+		return
+	}
+
+	changes := diffItems(existing, items)
+
+	var writes, skipped, conflicts []itemChange
+	for _, change := range changes {
+		switch {
+		case change.Kind == itemChangeCreate:
+			writes = append(writes, change)
+		case change.Kind == itemChangeUpdate && policy == conflictPolicyOverwrite:
+			writes = append(writes, change)
+		case change.Kind == itemChangeUpdate && policy == conflictPolicySkip:
+			skipped = append(skipped, change)
+		case change.Kind == itemChangeUpdate:
+			conflicts = append(conflicts, change)
+		}
+	}
+
+	counts := countItemChanges(changes)
+
+	if config.DryRun.ValueBool() {
+		for _, change := range changes {
+			message := fmt.Sprintf("%s %s", change.Kind, change.Key)
+			if change.Kind == itemChangeUpdate && policy != conflictPolicyOverwrite {
+				message = fmt.Sprintf("conflict %s (%s)", change.Key, policy)
+			}
+
+			resp.SendProgress(action.InvokeProgressEvent{Message: message})
+		}
+
+		if len(conflicts) > 0 {
+			resp.Diagnostics.AddWarning(
+				"Copy Would Fail",
+				fmt.Sprintf("%d items already exist in database ID %s with a different value: %s", len(conflicts), target_id, conflictingKeys(conflicts)),
+			)
+		}
+
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Dry run of copying %d items from database %s to database %s: %d to create, %d to update, %d unchanged, %d skipped, %d conflicting",
+				len(items), source_id, target_id, counts[itemChangeCreate], len(writes)-counts[itemChangeCreate], counts[itemChangeUnchanged], len(skipped), len(conflicts)),
+		})
+		return
+	}
+
+	if len(conflicts) > 0 {
+		resp.Diagnostics.AddError(
+			"Conflicting Database Items",
+			fmt.Sprintf("%d items already exist in database ID %s with a different value: %s. Set conflict_policy to %q or %q to copy the other items. No items were copied.",
+				len(conflicts), target_id, conflictingKeys(conflicts), conflictPolicyOverwrite, conflictPolicySkip),
+		)
+		return
+	}
+
+	for i, change := range writes {
+		_, err := a.client.CreateDatabaseItem(CreateDatabaseItemRequest{
+			Key:   change.Key,
+			Value: change.Value,
+		}, target_id)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Copying Database Item",
+				fmt.Sprintf("Could not write item %s to database ID %s after copying %d items: %s", change.Key, target_id, i, err),
+			)
+			return
+		}
+
+		if (i+1)%copyProgressInterval == 0 {
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("Copied %d of %d items to database %s", i+1, len(writes), target_id),
+			})
+		}
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Copied %d items from database %s to database %s: %d created, %d updated, %d unchanged, %d skipped",
+			len(writes), source_id, target_id, counts[itemChangeCreate], len(writes)-counts[itemChangeCreate], counts[itemChangeUnchanged], len(skipped)),
+	})
+}
+
+// conflictingKeys lists the keys of the first conflicts.
+func conflictingKeys(conflicts []itemChange) string {
+	keys := make([]string, 0, maxReportedConflicts)
+	for _, change := range conflicts {
+		if len(keys) == maxReportedConflicts {
+			keys = append(keys, fmt.Sprintf("and %d more", len(conflicts)-maxReportedConflicts))
+			break
+		}
+		keys = append(keys, change.Key)
+	}
+
+	return strings.Join(keys, ", ")
+}
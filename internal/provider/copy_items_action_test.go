@@ -0,0 +1,242 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// testCopyItemsServer serves the items of databases, keyed by database ID.
+func testCopyItemsServer(t *testing.T, databases map[string]map[string]string) *httptest.Server {
+	t.Helper()
+
+	var mu sync.Mutex
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+		if len(parts) != 3 || parts[0] != "database" || parts[2] != "items" || databases[parts[1]] == nil {
+			http.NotFound(w, r)
+			return
+		}
+		items := databases[parts[1]]
+
+		switch r.Method {
+		case "GET":
+			var keys []string
+			for k := range items {
+				if strings.HasPrefix(k, r.URL.Query().Get("prefix")) {
+					keys = append(keys, k)
+				}
+			}
+			sort.Strings(keys)
+
+			page := ListDatabaseItemsResponse{Items: []DatabaseItem{}}
+			for _, k := range keys {
+				page.Items = append(page.Items, DatabaseItem{Key: k, Value: items[k]})
+			}
+			_ = json.NewEncoder(w).Encode(page)
+		case "POST":
+			var req CreateDatabaseItemRequest
+			_ = json.NewDecoder(r.Body).Decode(&req)
+
+			items[req.Key] = req.Value
+			_ = json.NewEncoder(w).Encode(CreateDatabaseItemResponse{{Key: req.Key, Value: req.Value}})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestCopyItemsAction_Invoke(t *testing.T) {
+	overwrite, skip := conflictPolicyOverwrite, conflictPolicySkip
+	users, archiveUsers, invalidPrefix := "users/", "archive/users/", "archive//"
+	dryRun := true
+
+	cases := map[string]struct {
+		keyPrefix       *string
+		targetKeyPrefix *string
+		conflictPolicy  *string
+		dryRun          *bool
+		expectError     string
+		expectWarning   string
+		expectTarget    map[string]string
+		expectProgress  string
+	}{
+		"default policy fails on conflicts": {
+			expectError: "Conflicting Database Items",
+			expectTarget: map[string]string{
+				"users/alice": "old",
+				"users/bob":   "bob",
+			},
+		},
+		"overwrite": {
+			conflictPolicy: &overwrite,
+			expectTarget: map[string]string{
+				"users/alice":  "alice",
+				"users/bob":    "bob",
+				"users/carol":  "carol",
+				"sessions/abc": "session",
+			},
+			expectProgress: "Copied 3 items from database db-src to database db-dst: 2 created, 1 updated, 1 unchanged, 0 skipped",
+		},
+		"skip": {
+			conflictPolicy: &skip,
+			expectTarget: map[string]string{
+				"users/alice":  "old",
+				"users/bob":    "bob",
+				"users/carol":  "carol",
+				"sessions/abc": "session",
+			},
+			expectProgress: "Copied 2 items from database db-src to database db-dst: 2 created, 0 updated, 1 unchanged, 1 skipped",
+		},
+		"rewrite keys": {
+			keyPrefix:       &users,
+			targetKeyPrefix: &archiveUsers,
+			expectTarget: map[string]string{
+				"users/alice":         "old",
+				"users/bob":           "bob",
+				"archive/users/alice": "alice",
+				"archive/users/bob":   "bob",
+				"archive/users/carol": "carol",
+			},
+			expectProgress: "Copied 3 items from database db-src to database db-dst: 3 created, 0 updated, 0 unchanged, 0 skipped",
+		},
+		"invalid rewritten key": {
+			keyPrefix:       &users,
+			targetKeyPrefix: &invalidPrefix,
+			expectError:     "Invalid Target Item Key",
+			expectTarget: map[string]string{
+				"users/alice": "old",
+				"users/bob":   "bob",
+			},
+		},
+		"dry run": {
+			dryRun:        &dryRun,
+			expectWarning: "Copy Would Fail",
+			expectTarget: map[string]string{
+				"users/alice": "old",
+				"users/bob":   "bob",
+			},
+			expectProgress: "Dry run of copying 4 items from database db-src to database db-dst: 2 to create, 0 to update, 1 unchanged, 0 skipped, 1 conflicting",
+		},
+		"dry run overwrite": {
+			conflictPolicy: &overwrite,
+			dryRun:         &dryRun,
+			expectTarget: map[string]string{
+				"users/alice": "old",
+				"users/bob":   "bob",
+			},
+			expectProgress: "Dry run of copying 4 items from database db-src to database db-dst: 2 to create, 1 to update, 1 unchanged, 0 skipped, 0 conflicting",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			databases := map[string]map[string]string{
+				"db-src": {
+					"users/alice":  "alice",
+					"users/bob":    "bob",
+					"users/carol":  "carol",
+					"sessions/abc": "session",
+				},
+				"db-dst": {
+					"users/alice": "old",
+					"users/bob":   "bob",
+				},
+			}
+			server := testCopyItemsServer(t, databases)
+
+			a := &copyItemsAction{client: &Client{HostURL: server.URL, HTTPClient: server.Client()}}
+			ctx := context.Background()
+
+			schemaResp := &action.SchemaResponse{}
+			a.Schema(ctx, action.SchemaRequest{}, schemaResp)
+
+			var progress []string
+			resp := &action.InvokeResponse{
+				SendProgress: func(event action.InvokeProgressEvent) {
+					progress = append(progress, event.Message)
+				},
+			}
+
+			a.Invoke(ctx, action.InvokeRequest{
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{
+						"source_database_id": tftypes.NewValue(tftypes.String, "db-src"),
+						"target_database_id": tftypes.NewValue(tftypes.String, "db-dst"),
+						"key_prefix":         tftypes.NewValue(tftypes.String, tc.keyPrefix),
+						"target_key_prefix":  tftypes.NewValue(tftypes.String, tc.targetKeyPrefix),
+						"conflict_policy":    tftypes.NewValue(tftypes.String, tc.conflictPolicy),
+						"dry_run":            tftypes.NewValue(tftypes.Bool, tc.dryRun),
+					}),
+					Schema: schemaResp.Schema,
+				},
+			}, resp)
+
+			if tc.expectError != "" {
+				if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != tc.expectError {
+					t.Fatalf("got diagnostics %v, want %q", resp.Diagnostics, tc.expectError)
+				}
+			} else if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			if tc.expectWarning != "" {
+				if resp.Diagnostics.WarningsCount() != 1 || resp.Diagnostics.Warnings()[0].Summary() != tc.expectWarning {
+					t.Errorf("got diagnostics %v, want warning %q", resp.Diagnostics, tc.expectWarning)
+				}
+			}
+
+			if !reflect.DeepEqual(databases["db-dst"], tc.expectTarget) {
+				t.Errorf("got target items %v, want %v", databases["db-dst"], tc.expectTarget)
+			}
+
+			if tc.expectProgress != "" {
+				if last := progress[len(progress)-1]; last != tc.expectProgress {
+					t.Errorf("got final progress %q, want %q", last, tc.expectProgress)
+				}
+			}
+		})
+	}
+}
+
+func TestDiffItems(t *testing.T) {
+	existing := map[string]string{
+		"a": "1",
+		"b": "2",
+	}
+	items := []DatabaseItem{
+		{Key: "a", Value: "1"},
+		{Key: "b", Value: "3"},
+		{Key: "c", Value: "4"},
+	}
+
+	expected := []itemChange{
+		{Key: "a", Value: "1", Kind: itemChangeUnchanged},
+		{Key: "b", Value: "3", Kind: itemChangeUpdate},
+		{Key: "c", Value: "4", Kind: itemChangeCreate},
+	}
+
+	if got := diffItems(existing, items); !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %v, want %v", got, expected)
+	}
+}
@@ -0,0 +1,303 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// credentialPrivateKey is the private data key holding the identifiers
+	// needed to renew and revoke an issued credential.
+	credentialPrivateKey = "credential"
+
+	// credentialRenewMargin is how long before expiry Terraform is asked to
+	// renew a credential.
+	credentialRenewMargin = 1 * time.Minute
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ ephemeral.EphemeralResource              = &databaseCredentialsEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &databaseCredentialsEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithRenew     = &databaseCredentialsEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithClose     = &databaseCredentialsEphemeralResource{}
+)
+
+// NewDatabaseCredentialsEphemeralResource is a helper function to simplify the provider implementation.
+func NewDatabaseCredentialsEphemeralResource() ephemeral.EphemeralResource {
+	return &databaseCredentialsEphemeralResource{}
+}
+
+// databaseCredentialsEphemeralResource is the ephemeral resource implementation.
+type databaseCredentialsEphemeralResource struct {
+	client *Client
+}
+
+// databaseCredentialsEphemeralResourceModel maps the ephemeral resource schema data.
+type databaseCredentialsEphemeralResourceModel struct {
+	DatabaseId types.String `tfsdk:"database_id"`
+	Scope      types.String `tfsdk:"scope"`
+	TTLSeconds types.Int64  `tfsdk:"ttl_seconds"`
+	ID         types.String `tfsdk:"id"`
+	Key        types.String `tfsdk:"key"`
+	ExpiresAt  types.String `tfsdk:"expires_at"`
+}
+
+// databaseCredentialPrivateData is stored in private data between Open,
+// Renew and Close.
+type databaseCredentialPrivateData struct {
+	DatabaseId   string `json:"database_id"`
+	CredentialId string `json:"credential_id"`
+}
+
+// Metadata returns the ephemeral resource type name.
+func (r *databaseCredentialsEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_database_credentials"
+}
+
+// Schema defines the schema for the ephemeral resource.
+func (r *databaseCredentialsEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Issues a temporary, scoped credential for a database. The credential is renewed while Terraform needs it and revoked once Terraform is done, and is never stored in state.",
+		Attributes: map[string]schema.Attribute{
+			"database_id": schema.StringAttribute{
+				Description: "ID of the database the credential grants access to.",
+				Required:    true,
+			},
+			"scope": schema.StringAttribute{
+				Description: "Permission scope of the credential. One of `read`, `write` or `admin`.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("read", "write", "admin"),
+				},
+			},
+			"ttl_seconds": schema.Int64Attribute{
+				Description: "Requested lifetime of the credential in seconds. Defaults to the API's default lifetime.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(60, 43200),
+				},
+			},
+			"id": schema.StringAttribute{
+				Description: "ID of the issued credential.",
+				Computed:    true,
+			},
+			"key": schema.StringAttribute{
+				Description: "Secret key of the issued credential.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"expires_at": schema.StringAttribute{
+				Description: "RFC 3339 timestamp at which the credential expires unless renewed.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the ephemeral resource.
+func (r *databaseCredentialsEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *provider.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Open issues a new credential for the configured database.
+func (r *databaseCredentialsEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data databaseCredentialsEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	credential, err := r.client.CreateDatabaseCredential(CreateDatabaseCredentialRequest{
+		Scope:      data.Scope.ValueString(),
+		TTLSeconds: data.TTLSeconds.ValueInt64(),
+	}, data.DatabaseId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Issuing Database Credential",
+			"Could not issue credential for database ID "+data.DatabaseId.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Issued database credential", map[string]any{
+		"database_id":   data.DatabaseId.ValueString(),
+		"credential_id": credential.Id,
+		"expires_at":    credential.ExpiresAt,
+	})
+
+	data.ID = types.StringValue(credential.Id)
+	data.Key = types.StringValue(credential.Key)
+	data.ExpiresAt = types.StringValue(credential.ExpiresAt)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+	if !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(setDatabaseCredentialPrivateData(ctx, resp.Private, data.DatabaseId.ValueString(), credential.Id)...)
+	}
+	if resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(revokeUnusedDatabaseCredential(r.client, data.DatabaseId.ValueString(), credential.Id)...)
+		return
+	}
+
+	resp.RenewAt = credentialRenewAt(credential.ExpiresAt)
+}
+
+// Renew extends the lifetime of the credential issued in Open.
+func (r *databaseCredentialsEphemeralResource) Renew(ctx context.Context, req ephemeral.RenewRequest, resp *ephemeral.RenewResponse) {
+	renewAt, diags := renewDatabaseCredential(ctx, r.client, req.Private)
+	resp.Diagnostics.Append(diags...)
+	resp.RenewAt = renewAt
+}
+
+// Close revokes the credential issued in Open.
+func (r *databaseCredentialsEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	resp.Diagnostics.Append(revokeDatabaseCredential(ctx, r.client, req.Private)...)
+}
+
+// privateDataGetter and privateDataSetter are satisfied by the framework's
+// private data type, which lives in an internal package.
+type privateDataGetter interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
+
+type privateDataSetter interface {
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// setDatabaseCredentialPrivateData stores the identifiers needed to renew
+// and revoke a credential issued in Open.
+func setDatabaseCredentialPrivateData(ctx context.Context, private privateDataSetter, database_id, credential_id string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	raw, err := json.Marshal(databaseCredentialPrivateData{
+		DatabaseId:   database_id,
+		CredentialId: credential_id,
+	})
+	if err != nil {
+		diags.AddError(
+			"Error Storing Database Credential",
+			"Could not encode credential private data: "+err.Error(),
+		)
+		return diags
+	}
+
+	diags.Append(private.SetKey(ctx, credentialPrivateKey, raw)...)
+	return diags
+}
+
+// getDatabaseCredentialPrivateData decodes the identifiers stored by Open.
+func getDatabaseCredentialPrivateData(ctx context.Context, private privateDataGetter) (*databaseCredentialPrivateData, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	raw, getDiags := private.GetKey(ctx, credentialPrivateKey)
+	diags.Append(getDiags...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	var data databaseCredentialPrivateData
+	if err := json.Unmarshal(raw, &data); err != nil {
+		diags.AddError(
+			"Error Reading Database Credential",
+			"Could not decode credential private data: "+err.Error(),
+		)
+		return nil, diags
+	}
+
+	return &data, diags
+}
+
+// renewDatabaseCredential renews the credential stored in private and
+// returns when it should next be renewed.
+func renewDatabaseCredential(ctx context.Context, client *Client, private privateDataGetter) (time.Time, diag.Diagnostics) {
+	privateData, diags := getDatabaseCredentialPrivateData(ctx, private)
+	if diags.HasError() {
+		return time.Time{}, diags
+	}
+
+	credential, err := client.RenewDatabaseCredential(privateData.DatabaseId, privateData.CredentialId)
+	if err != nil {
+		diags.AddError(
+			"Error Renewing Database Credential",
+			"Could not renew credential "+privateData.CredentialId+": "+err.Error(),
+		)
+		return time.Time{}, diags
+	}
+
+	return credentialRenewAt(credential.ExpiresAt), diags
+}
+
+// revokeDatabaseCredential revokes the credential stored in private.
+func revokeDatabaseCredential(ctx context.Context, client *Client, private privateDataGetter) diag.Diagnostics {
+	privateData, diags := getDatabaseCredentialPrivateData(ctx, private)
+	if diags.HasError() {
+		return diags
+	}
+
+	if err := client.RevokeDatabaseCredential(privateData.DatabaseId, privateData.CredentialId); err != nil {
+		diags.AddError(
+			"Error Revoking Database Credential",
+			"Could not revoke credential "+privateData.CredentialId+": "+err.Error(),
+		)
+	}
+
+	return diags
+}
+
+// revokeUnusedDatabaseCredential revokes a credential issued by an Open that
+// then failed. Terraform does not call Close after a failed Open, so the
+// credential would otherwise stay valid until it expires.
+func revokeUnusedDatabaseCredential(client *Client, database_id, credential_id string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if err := client.RevokeDatabaseCredential(database_id, credential_id); err != nil {
+		diags.AddWarning(
+			"Error Revoking Database Credential",
+			"Could not revoke credential "+credential_id+" after opening it failed. It stays valid until it expires: "+err.Error(),
+		)
+	}
+
+	return diags
+}
+
+// credentialRenewAt returns when Terraform should renew a credential that
+// expires at the given RFC 3339 timestamp. A zero time disables renewal.
+func credentialRenewAt(expiresAt string) time.Time {
+	expires, err := time.Parse(time.RFC3339, expiresAt)
+	if err != nil {
+		return time.Time{}
+	}
+
+	return expires.Add(-credentialRenewMargin)
+}
@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	ephemeralschema "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+
+	"terraform-provider-hashicups/internal/fakebdcc"
+)
+
+func TestAccDatabaseCredentialsEphemeralResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		// Ephemeral resources are only available in 1.10 and later
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithEcho,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseCredentialsEphemeralResourceConfig("read"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"echo.test",
+						tfjsonpath.New("data").AtMapKey("scope"),
+						knownvalue.StringExact("read"),
+					),
+					statecheck.ExpectKnownValue(
+						"echo.test",
+						tfjsonpath.New("data").AtMapKey("key"),
+						knownvalue.NotNull(),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestAccDatabaseCredentialsEphemeralResource_InvalidScope(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithEcho,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDatabaseCredentialsEphemeralResourceConfig("owner"),
+				ExpectError: regexp.MustCompile(`value must be one of`),
+			},
+		},
+	})
+}
+
+func testAccDatabaseCredentialsEphemeralResourceConfig(scope string) string {
+	return testAccProviderConfig() + fmt.Sprintf(`
+resource "bobsdiscountcloudco_database" "test" {
+  name                = "tf-acc-credentials"
+  deletion_protection = false
+}
+
+ephemeral "bobsdiscountcloudco_database_credentials" "test" {
+  database_id = bobsdiscountcloudco_database.test.id
+  scope       = %[1]q
+}
+
+provider "echo" {
+  data = ephemeral.bobsdiscountcloudco_database_credentials.test
+}
+
+resource "echo" "test" {}
+`, scope)
+}
+
+// testEphemeralOpenResultError opens r with config against a result that
+// cannot hold its model, so Open fails after issuing a credential, and
+// returns the diagnostics.
+func testEphemeralOpenResultError(t *testing.T, r ephemeral.EphemeralResource, config map[string]tftypes.Value) *ephemeral.OpenResponse {
+	t.Helper()
+	ctx := context.Background()
+
+	schemaResp := &ephemeral.SchemaResponse{}
+	r.Schema(ctx, ephemeral.SchemaRequest{}, schemaResp)
+
+	resp := &ephemeral.OpenResponse{
+		Result: tfsdk.EphemeralResultData{
+			Raw:    tftypes.NewValue(tftypes.Object{}, nil),
+			Schema: ephemeralschema.Schema{},
+		},
+	}
+
+	r.Open(ctx, ephemeral.OpenRequest{
+		Config: tfsdk.Config{
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), config),
+			Schema: schemaResp.Schema,
+		},
+	}, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected Open to fail")
+	}
+
+	return resp
+}
+
+// testFakeDatabase starts the fake API with one database and returns a
+// client for it and the database ID.
+func testFakeDatabase(t *testing.T) (*Client, string) {
+	t.Helper()
+
+	server := httptest.NewServer(fakebdcc.New(fakebdcc.Options{}))
+	t.Cleanup(server.Close)
+
+	client := &Client{HostURL: server.URL, HTTPClient: server.Client()}
+
+	database, err := client.CreateDatabase(CreateDatabaseRequest{Name: "orders"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	return client, database.Id
+}
+
+func TestDatabaseCredentialsEphemeralResource_OpenRevokesOnError(t *testing.T) {
+	client, database_id := testFakeDatabase(t)
+
+	testEphemeralOpenResultError(t, &databaseCredentialsEphemeralResource{client: client}, map[string]tftypes.Value{
+		"database_id": tftypes.NewValue(tftypes.String, database_id),
+		"scope":       tftypes.NewValue(tftypes.String, "read"),
+		"ttl_seconds": tftypes.NewValue(tftypes.Number, nil),
+		"id":          tftypes.NewValue(tftypes.String, nil),
+		"key":         tftypes.NewValue(tftypes.String, nil),
+		"expires_at":  tftypes.NewValue(tftypes.String, nil),
+	})
+
+	credentials, err := client.ListDatabaseCredentials(database_id)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(credentials) != 0 {
+		t.Errorf("got %d credentials left after a failed Open, want 0", len(credentials))
+	}
+}
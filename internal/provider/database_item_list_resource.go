@@ -0,0 +1,152 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ list.ListResource              = &databaseItemListResource{}
+	_ list.ListResourceWithConfigure = &databaseItemListResource{}
+)
+
+// NewDatabaseItemListResource is a helper function to simplify the provider implementation.
+func NewDatabaseItemListResource() list.ListResource {
+	return &databaseItemListResource{}
+}
+
+// databaseItemListResource is the list resource implementation.
+type databaseItemListResource struct {
+	client *Client
+}
+
+// databaseItemListResourceModel maps the list resource config schema data.
+type databaseItemListResourceModel struct {
+	DatabaseId types.String `tfsdk:"database_id"`
+	KeyPrefix  types.String `tfsdk:"key_prefix"`
+}
+
+// Metadata returns the resource type name.
+func (r *databaseItemListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_database_item"
+}
+
+// ListResourceConfigSchema defines the schema for the list block.
+func (r *databaseItemListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the items of a database, optionally filtered by key prefix.",
+		Attributes: map[string]schema.Attribute{
+			"database_id": schema.StringAttribute{
+				Description: "ID of the database to list items of.",
+				Required:    true,
+			},
+			"key_prefix": schema.StringAttribute{
+				Description: "Only return items whose key starts with this prefix.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the list resource.
+func (r *databaseItemListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *provider.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// List streams every item of the configured database.
+func (r *databaseItemListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config databaseItemListResourceModel
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	database_id := config.DatabaseId.ValueString()
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		listRequest := ListDatabaseItemsRequest{
+			Prefix: config.KeyPrefix.ValueString(),
+		}
+		var count int64
+
+		for {
+			page, err := r.client.ListDatabaseItems(listRequest, database_id)
+			if err != nil {
+				result := req.NewListResult(ctx)
+				result.Diagnostics.AddError(
+					"Unable to List Database Items",
+					"Could not list items of database ID "+database_id+": "+err.Error(),
+				)
+				push(result)
+				return
+			}
+
+			for _, item := range page.Items {
+				if req.Limit > 0 && count >= req.Limit {
+					return
+				}
+				count++
+
+				if !push(r.listResult(ctx, req, database_id, item)) {
+					return
+				}
+			}
+
+			if page.NextToken == "" {
+				return
+			}
+			listRequest.NextToken = page.NextToken
+		}
+	}
+}
+
+// listResult builds the list result for a single item.
+func (r *databaseItemListResource) listResult(ctx context.Context, req list.ListRequest, database_id string, item DatabaseItem) list.ListResult {
+	result := req.NewListResult(ctx)
+	result.DisplayName = item.Key
+
+	result.Diagnostics.Append(result.Identity.Set(ctx, databaseItemIdentityModel{
+		DatabaseId: types.StringValue(database_id),
+		Key:        types.StringValue(item.Key),
+	})...)
+
+	if !req.IncludeResource || result.Diagnostics.HasError() {
+		return result
+	}
+
+	result.Diagnostics.Append(result.Resource.Set(ctx, databaseItemResourceModel{
+		ID:         types.StringValue(databaseItemID(database_id, item.Key)),
+		DatabaseId: types.StringValue(database_id),
+		Key:        types.StringValue(item.Key),
+		Value:      types.StringValue(item.Value),
+	})...)
+
+	return result
+}
@@ -0,0 +1,179 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// testListItemsPageSize is the page size of testListItemsServer, small
+// enough that every listing spans several pages.
+const testListItemsPageSize = 2
+
+// testListItemsServer serves the items of database db-1 in pages of
+// testListItemsPageSize, using the offset of the next page as its token.
+func testListItemsServer(t *testing.T, items map[string]string) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/database/db-1/items" {
+			http.NotFound(w, r)
+			return
+		}
+
+		var keys []string
+		for k := range items {
+			if strings.HasPrefix(k, r.URL.Query().Get("prefix")) {
+				keys = append(keys, k)
+			}
+		}
+		sort.Strings(keys)
+
+		offset := 0
+		if token := r.URL.Query().Get("next_token"); token != "" {
+			var err error
+			if offset, err = strconv.Atoi(token); err != nil {
+				http.Error(w, "invalid next_token", http.StatusBadRequest)
+				return
+			}
+		}
+
+		page := ListDatabaseItemsResponse{Items: []DatabaseItem{}}
+		for i := offset; i < len(keys) && i < offset+testListItemsPageSize; i++ {
+			page.Items = append(page.Items, DatabaseItem{Key: keys[i], Value: items[keys[i]]})
+		}
+		if offset+testListItemsPageSize < len(keys) {
+			page.NextToken = strconv.Itoa(offset + testListItemsPageSize)
+		}
+		_ = json.NewEncoder(w).Encode(page)
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestDatabaseItemListResource_List(t *testing.T) {
+	server := testListItemsServer(t, map[string]string{
+		"sessions/1":  "s1",
+		"users/alice": "alice",
+		"users/bob":   "bob",
+		"users/carol": "carol",
+		"users/dave":  "dave",
+		"users/erin":  "erin",
+	})
+	r := &databaseItemListResource{client: &Client{HostURL: server.URL, HTTPClient: server.Client()}}
+	users, orders := "users/", "orders/"
+
+	cases := map[string]struct {
+		keyPrefix       *string
+		limit           int64
+		includeResource bool
+		want            []string
+	}{
+		"all pages": {
+			want: []string{"sessions/1", "users/alice", "users/bob", "users/carol", "users/dave", "users/erin"},
+		},
+		"key prefix": {
+			keyPrefix: &users,
+			want:      []string{"users/alice", "users/bob", "users/carol", "users/dave", "users/erin"},
+		},
+		"no matches": {
+			keyPrefix: &orders,
+		},
+		"limit across pages": {
+			keyPrefix: &users,
+			limit:     3,
+			want:      []string{"users/alice", "users/bob", "users/carol"},
+		},
+		"include resource": {
+			keyPrefix:       &users,
+			includeResource: true,
+			want:            []string{"users/alice", "users/bob", "users/carol", "users/dave", "users/erin"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			req := testListRequest(t, r, &databaseItemResource{}, map[string]tftypes.Value{
+				"database_id": tftypes.NewValue(tftypes.String, "db-1"),
+				"key_prefix":  tftypes.NewValue(tftypes.String, tc.keyPrefix),
+			}, tc.includeResource, tc.limit)
+
+			stream := &list.ListResultsStream{}
+			r.List(ctx, req, stream)
+
+			var got []string
+			for result := range stream.Results {
+				if result.Diagnostics.HasError() {
+					t.Fatalf("unexpected diagnostics: %v", result.Diagnostics)
+				}
+				got = append(got, result.DisplayName)
+
+				var identity databaseItemIdentityModel
+				if diags := result.Identity.Get(ctx, &identity); diags.HasError() {
+					t.Fatalf("unexpected identity diagnostics: %v", diags)
+				}
+				if identity.DatabaseId.ValueString() != "db-1" || identity.Key.ValueString() != result.DisplayName {
+					t.Errorf("got identity %+v for item %q", identity, result.DisplayName)
+				}
+
+				if tc.includeResource {
+					var model databaseItemResourceModel
+					if diags := result.Resource.Get(ctx, &model); diags.HasError() {
+						t.Fatalf("unexpected resource diagnostics: %v", diags)
+					}
+					if model.ID.ValueString() != "db-1/"+result.DisplayName || model.Value.IsNull() {
+						t.Errorf("unexpected resource %+v for item %q", model, result.DisplayName)
+					}
+				}
+			}
+
+			if len(got) != len(tc.want) {
+				t.Fatalf("got %v, want %v", got, tc.want)
+			}
+			for i := range got {
+				if got[i] != tc.want[i] {
+					t.Errorf("got %v, want %v", got, tc.want)
+				}
+			}
+		})
+	}
+}
+
+func TestDatabaseItemListResource_ListError(t *testing.T) {
+	server := testListItemsServer(t, map[string]string{})
+	r := &databaseItemListResource{client: &Client{HostURL: server.URL, HTTPClient: server.Client()}}
+	ctx := context.Background()
+
+	req := testListRequest(t, r, &databaseItemResource{}, map[string]tftypes.Value{
+		"database_id": tftypes.NewValue(tftypes.String, "db-missing"),
+		"key_prefix":  tftypes.NewValue(tftypes.String, nil),
+	}, false, 0)
+
+	stream := &list.ListResultsStream{}
+	r.List(ctx, req, stream)
+
+	var results []list.ListResult
+	for result := range stream.Results {
+		results = append(results, result)
+	}
+
+	if len(results) != 1 || !results[0].Diagnostics.HasError() {
+		t.Fatalf("got %d results, want a single error result", len(results))
+	}
+	if got := results[0].Diagnostics.Errors()[0].Summary(); got != "Unable to List Database Items" {
+		t.Errorf("got error %q, want %q", got, "Unable to List Database Items")
+	}
+}
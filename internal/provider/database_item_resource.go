@@ -0,0 +1,294 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &databaseItemResource{}
+	_ resource.ResourceWithConfigure   = &databaseItemResource{}
+	_ resource.ResourceWithIdentity    = &databaseItemResource{}
+	_ resource.ResourceWithImportState = &databaseItemResource{}
+)
+
+// NewDatabaseItemResource is a helper function to simplify the provider implementation.
+func NewDatabaseItemResource() resource.Resource {
+	return &databaseItemResource{}
+}
+
+// databaseItemResource is the resource implementation.
+type databaseItemResource struct {
+	client *Client
+}
+
+// databaseItemResourceModel maps the resource schema data.
+type databaseItemResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	DatabaseId types.String `tfsdk:"database_id"`
+	Key        types.String `tfsdk:"key"`
+	Value      types.String `tfsdk:"value"`
+}
+
+// databaseItemIdentityModel maps the resource identity schema data.
+type databaseItemIdentityModel struct {
+	DatabaseId types.String `tfsdk:"database_id"`
+	Key        types.String `tfsdk:"key"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *databaseItemResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Metadata returns the resource type name.
+func (r *databaseItemResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_database_item"
+}
+
+// Schema defines the schema for the resource.
+func (r *databaseItemResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a single key/value item in a database.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Import ID of the item, in the form `<database_id>/<key>`.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"database_id": schema.StringAttribute{
+				Description: "ID of the database holding the item.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"key": schema.StringAttribute{
+				Description: "Key of the item.",
+				Required:    true,
+				Validators:  itemKeyValidators(),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"value": schema.StringAttribute{
+				Description: "Value of the item.",
+				Required:    true,
+				Validators:  itemValueValidators(),
+			},
+		},
+	}
+}
+
+// IdentitySchema defines the identity schema for the resource.
+func (r *databaseItemResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"database_id": identityschema.StringAttribute{
+				Description:       "ID of the database holding the item.",
+				RequiredForImport: true,
+			},
+			"key": identityschema.StringAttribute{
+				Description:       "Key of the item.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *databaseItemResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan databaseItemResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.put(&plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.setIdentity(ctx, resp.Identity, plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *databaseItemResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state databaseItemResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	item, err := r.client.GetDatabaseItem(state.DatabaseId.ValueString(), state.Key.ValueString())
+	if IsNotFound(err) {
+		// Deleted outside of Terraform; plan to create it again.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Database Item",
+			"Could not read key "+state.Key.ValueString()+" of database ID "+state.DatabaseId.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.ID = types.StringValue(databaseItemID(state.DatabaseId.ValueString(), item.Key))
+	state.Key = types.StringValue(item.Key)
+	state.Value = types.StringValue(item.Value)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.setIdentity(ctx, resp.Identity, state)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *databaseItemResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan databaseItemResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.put(&plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.setIdentity(ctx, resp.Identity, plan)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *databaseItemResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state databaseItemResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteDatabaseItem(state.DatabaseId.ValueString(), state.Key.ValueString())
+	if err != nil && !IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting Database Item",
+			"Could not delete key "+state.Key.ValueString()+" of database ID "+state.DatabaseId.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports an item by an ID of the form <database_id>/<key> or
+// by identity.
+func (r *databaseItemResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var identity databaseItemIdentityModel
+
+	if req.ID != "" {
+		database_id, key, ok := strings.Cut(req.ID, "/")
+		if !ok || database_id == "" || key == "" {
+			resp.Diagnostics.AddError(
+				"Invalid Import ID",
+				fmt.Sprintf("Expected an import ID of the form <database_id>/<key>, got %q.", req.ID),
+			)
+			return
+		}
+
+		identity.DatabaseId = types.StringValue(database_id)
+		identity.Key = types.StringValue(key)
+	} else if req.Identity != nil {
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("database_id"), identity.DatabaseId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("key"), identity.Key)...)
+	if resp.Identity != nil {
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, identity)...)
+	}
+}
+
+// put writes the planned item and populates its computed attributes.
+func (r *databaseItemResource) put(plan *databaseItemResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	_, err := r.client.CreateDatabaseItem(CreateDatabaseItemRequest{
+		Key:   plan.Key.ValueString(),
+		Value: plan.Value.ValueString(),
+	}, plan.DatabaseId.ValueString())
+	if err != nil {
+		diags.AddError(
+			"Error Writing Database Item",
+			"Could not write key "+plan.Key.ValueString()+" to database ID "+plan.DatabaseId.ValueString()+": "+err.Error(),
+		)
+		return diags
+	}
+
+	plan.ID = types.StringValue(databaseItemID(plan.DatabaseId.ValueString(), plan.Key.ValueString()))
+
+	return diags
+}
+
+// setIdentity stores the identity of an item, when Terraform supports it.
+func (r *databaseItemResource) setIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, item databaseItemResourceModel) diag.Diagnostics {
+	if identity == nil {
+		return nil
+	}
+
+	return identity.Set(ctx, databaseItemIdentityModel{
+		DatabaseId: item.DatabaseId,
+		Key:        item.Key,
+	})
+}
+
+// databaseItemID returns the import ID of an item.
+func databaseItemID(database_id, key string) string {
+	return database_id + "/" + key
+}
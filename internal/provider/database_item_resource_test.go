@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccDatabaseItemResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccDatabaseItemResourceConfig("one"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"bobsdiscountcloudco_database_item.test",
+						tfjsonpath.New("value"),
+						knownvalue.StringExact("one"),
+					),
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:      "bobsdiscountcloudco_database_item.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccDatabaseItemResourceConfig("two"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"bobsdiscountcloudco_database_item.test",
+						tfjsonpath.New("value"),
+						knownvalue.StringExact("two"),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestAccDatabaseItemResource_Identity(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		// Resource identity is only available in 1.12 and later
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseItemResourceConfig("one"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentity("bobsdiscountcloudco_database_item.test", map[string]knownvalue.Check{
+						"database_id": knownvalue.NotNull(),
+						"key":         knownvalue.StringExact("config/flags"),
+					}),
+				},
+			},
+			{
+				ResourceName:    "bobsdiscountcloudco_database_item.test",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
+func testAccDatabaseItemResourceConfig(value string) string {
+	return testAccProviderConfig() + fmt.Sprintf(`
+resource "bobsdiscountcloudco_database" "test" {
+  name                = "tf-acc-item"
+  deletion_protection = false
+}
+
+resource "bobsdiscountcloudco_database_item" "test" {
+  database_id = bobsdiscountcloudco_database.test.id
+  key         = "config/flags"
+  value       = %[1]q
+}
+`, value)
+}
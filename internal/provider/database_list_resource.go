@@ -0,0 +1,169 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ list.ListResource              = &databaseListResource{}
+	_ list.ListResourceWithConfigure = &databaseListResource{}
+)
+
+// NewDatabaseListResource is a helper function to simplify the provider implementation.
+func NewDatabaseListResource() list.ListResource {
+	return &databaseListResource{}
+}
+
+// databaseListResource is the list resource implementation.
+type databaseListResource struct {
+	client *Client
+}
+
+// databaseListResourceModel maps the list resource config schema data.
+type databaseListResourceModel struct {
+	NamePrefix types.String `tfsdk:"name_prefix"`
+	Tags       types.Map    `tfsdk:"tags"`
+}
+
+// Metadata returns the resource type name.
+func (r *databaseListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_database"
+}
+
+// ListResourceConfigSchema defines the schema for the list block.
+func (r *databaseListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists databases, optionally filtered by name prefix and tags.",
+		Attributes: map[string]schema.Attribute{
+			"name_prefix": schema.StringAttribute{
+				Description: "Only return databases whose name starts with this prefix.",
+				Optional:    true,
+			},
+			"tags": schema.MapAttribute{
+				Description: "Only return databases that have all of these tags.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the list resource.
+func (r *databaseListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *provider.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// List streams every database matching the configured filters.
+func (r *databaseListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config databaseListResourceModel
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	filter, diags := tagsFromMap(ctx, config.Tags)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		listRequest := ListDatabasesRequest{
+			NamePrefix: config.NamePrefix.ValueString(),
+		}
+		var count int64
+
+		for {
+			page, err := r.client.ListDatabases(listRequest)
+			if err != nil {
+				result := req.NewListResult(ctx)
+				result.Diagnostics.AddError(
+					"Unable to List Databases",
+					err.Error(),
+				)
+				push(result)
+				return
+			}
+
+			for _, database := range page.Databases {
+				if !tagsMatch(filter, database.Tags) {
+					continue
+				}
+
+				if req.Limit > 0 && count >= req.Limit {
+					return
+				}
+				count++
+
+				if !push(r.listResult(ctx, req, database)) {
+					return
+				}
+			}
+
+			if page.NextToken == "" {
+				return
+			}
+			listRequest.NextToken = page.NextToken
+		}
+	}
+}
+
+// listResult builds the list result for a single database.
+func (r *databaseListResource) listResult(ctx context.Context, req list.ListRequest, database Database) list.ListResult {
+	result := req.NewListResult(ctx)
+	result.DisplayName = database.Name
+
+	identity := newDatabaseIdentity(r.client, database.Id)
+	result.Diagnostics.Append(result.Identity.Set(ctx, identity)...)
+
+	if !req.IncludeResource || result.Diagnostics.HasError() {
+		return result
+	}
+
+	full, err := r.client.GetDatabase(database.Id)
+	if err != nil {
+		result.Diagnostics.AddError(
+			"Unable to Read Database",
+			"Could not read database ID "+database.Id+": "+err.Error(),
+		)
+		return result
+	}
+
+	model, diags := newDatabaseResourceModel(ctx, full)
+	result.Diagnostics.Append(diags...)
+	if result.Diagnostics.HasError() {
+		return result
+	}
+
+	result.Diagnostics.Append(result.Resource.Set(ctx, model)...)
+
+	return result
+}
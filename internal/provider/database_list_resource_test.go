@@ -0,0 +1,168 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// testListDatabasesServer serves two pages of databases.
+func testListDatabasesServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	pages := map[string]ListDatabasesResponse{
+		"": {
+			Databases: []Database{
+				{Id: "db-1", Name: "orders", Tags: map[string]string{"environment": "prod"}},
+				{Id: "db-2", Name: "orders-staging", Tags: map[string]string{"environment": "staging"}},
+			},
+			NextToken: "page-2",
+		},
+		"page-2": {
+			Databases: []Database{
+				{Id: "db-3", Name: "inventory", Tags: map[string]string{"environment": "prod"}},
+			},
+		},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/database":
+			page, ok := pages[r.URL.Query().Get("next_token")]
+			if !ok {
+				http.Error(w, "unknown page", http.StatusBadRequest)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(page)
+		case "/database/db-1", "/database/db-3":
+			_ = json.NewEncoder(w).Encode(CreateDatabaseResponse{Id: r.URL.Path[len("/database/"):], Name: "full", Tier: "standard"})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+// testListRequest builds a request to list res with r.
+func testListRequest(t *testing.T, r list.ListResource, res resource.ResourceWithIdentity, config map[string]tftypes.Value, includeResource bool, limit int64) list.ListRequest {
+	t.Helper()
+	ctx := context.Background()
+
+	schemaResp := &list.ListResourceSchemaResponse{}
+	r.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, schemaResp)
+
+	resourceSchemaResp := &resource.SchemaResponse{}
+	res.Schema(ctx, resource.SchemaRequest{}, resourceSchemaResp)
+
+	identitySchemaResp := &resource.IdentitySchemaResponse{}
+	res.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, identitySchemaResp)
+
+	configType := schemaResp.Schema.Type().TerraformType(ctx)
+
+	return list.ListRequest{
+		Config: tfsdk.Config{
+			Raw:    tftypes.NewValue(configType, config),
+			Schema: schemaResp.Schema,
+		},
+		IncludeResource:        includeResource,
+		Limit:                  limit,
+		ResourceSchema:         resourceSchemaResp.Schema,
+		ResourceIdentitySchema: identitySchemaResp.IdentitySchema,
+	}
+}
+
+func TestDatabaseListResource_List(t *testing.T) {
+	server := testListDatabasesServer(t)
+	r := &databaseListResource{client: &Client{HostURL: server.URL, HTTPClient: server.Client()}}
+	tagsType := tftypes.Map{ElementType: tftypes.String}
+
+	cases := map[string]struct {
+		tags            tftypes.Value
+		limit           int64
+		includeResource bool
+		want            []string
+	}{
+		"all pages": {
+			tags: tftypes.NewValue(tagsType, nil),
+			want: []string{"orders", "orders-staging", "inventory"},
+		},
+		"tag filter": {
+			tags: tftypes.NewValue(tagsType, map[string]tftypes.Value{
+				"environment": tftypes.NewValue(tftypes.String, "prod"),
+			}),
+			want: []string{"orders", "inventory"},
+		},
+		"limit": {
+			tags:  tftypes.NewValue(tagsType, nil),
+			limit: 2,
+			want:  []string{"orders", "orders-staging"},
+		},
+		"include resource": {
+			tags: tftypes.NewValue(tagsType, map[string]tftypes.Value{
+				"environment": tftypes.NewValue(tftypes.String, "prod"),
+			}),
+			includeResource: true,
+			want:            []string{"orders", "inventory"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			req := testListRequest(t, r, &databaseResource{}, map[string]tftypes.Value{
+				"name_prefix": tftypes.NewValue(tftypes.String, nil),
+				"tags":        tc.tags,
+			}, tc.includeResource, tc.limit)
+
+			stream := &list.ListResultsStream{}
+			r.List(ctx, req, stream)
+
+			var got []string
+			for result := range stream.Results {
+				if result.Diagnostics.HasError() {
+					t.Fatalf("unexpected diagnostics: %v", result.Diagnostics)
+				}
+				got = append(got, result.DisplayName)
+
+				var identity databaseIdentityModel
+				if diags := result.Identity.Get(ctx, &identity); diags.HasError() {
+					t.Fatalf("unexpected identity diagnostics: %v", diags)
+				}
+				if identity.ID.IsNull() {
+					t.Errorf("result %q has no identity ID", result.DisplayName)
+				}
+
+				if tc.includeResource {
+					var model databaseResourceModel
+					if diags := result.Resource.Get(ctx, &model); diags.HasError() {
+						t.Fatalf("unexpected resource diagnostics: %v", diags)
+					}
+					if model.ID.ValueString() != identity.ID.ValueString() || model.Tier.ValueString() != "standard" {
+						t.Errorf("unexpected resource %+v for identity %+v", model, identity)
+					}
+				}
+			}
+
+			if len(got) != len(tc.want) {
+				t.Fatalf("got %v, want %v", got, tc.want)
+			}
+			for i := range got {
+				if got[i] != tc.want[i] {
+					t.Errorf("got %v, want %v", got, tc.want)
+				}
+			}
+		})
+	}
+}
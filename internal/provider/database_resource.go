@@ -3,17 +3,30 @@ package provider
 import (
 	"context"
 	"fmt"
-	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource              = &databaseResource{}
-	_ resource.ResourceWithConfigure = &databaseResource{}
+	_ resource.Resource                   = &databaseResource{}
+	_ resource.ResourceWithConfigure      = &databaseResource{}
+	_ resource.ResourceWithModifyPlan     = &databaseResource{}
+	_ resource.ResourceWithValidateConfig = &databaseResource{}
+	_ resource.ResourceWithIdentity       = &databaseResource{}
+	_ resource.ResourceWithImportState    = &databaseResource{}
 )
 
 // Configure adds the provider configured client to the resource.
@@ -56,25 +69,348 @@ func (r *databaseResource) Metadata(_ context.Context, req resource.MetadataRequ
 // Schema defines the schema for the resource.
 func (r *databaseResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: databaseResourceSchemaVersion,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"last_updated": schema.StringAttribute{
-				Computed: true,
+				Description:        "Deprecated alias of updated_at.",
+				DeprecationMessage: "Use updated_at instead. last_updated will be removed in a future release.",
+				Computed:           true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "RFC 3339 timestamp at which the database was created, as reported by the API.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "RFC 3339 timestamp at which the database was last modified, as reported by the API.",
+				Computed:    true,
 			},
 			"name": schema.StringAttribute{
-				Required: true,
+				Required:   true,
+				Validators: databaseNameValidators(),
+			},
+			"deletion_protection": schema.BoolAttribute{
+				Description: "Whether the database is protected from deletion. Must be set to false and applied before the database can be destroyed. Defaults to true for new databases; existing databases keep their current setting.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					defaultOnCreate(true),
+				},
+			},
+			"tier": schema.StringAttribute{
+				Description: "Service tier of the database. One of `free`, `standard` or `premium`. Defaults to `standard`. Can be changed in place.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(defaultDatabaseTier),
+				Validators: []validator.String{
+					stringvalidator.OneOf(databaseTierNames()...),
+				},
+			},
+			"storage_gb": schema.Int64Attribute{
+				Description: "Storage capacity in GB. Defaults to the tier's default capacity, and follows it when the tier changes. Can be increased in place; decreasing it, including by moving to a smaller tier, forces a new database.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					tierDefaultStorage(),
+					int64planmodifier.RequiresReplaceIf(
+						storageShrinkRequiresReplace,
+						"Decreasing storage_gb requires replacing the database.",
+						"Decreasing `storage_gb` requires replacing the database.",
+					),
+				},
+			},
+			"max_items": schema.Int64Attribute{
+				Description: "Maximum number of items the database may hold. Defaults to the tier's limit, and follows it when the tier changes. Can be changed in place.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					tierDefaultMaxItems(),
+				},
+			},
+			"engine_version": schema.StringAttribute{
+				Description: "Storage engine version, e.g. `2.1`. Defaults to the latest version. Changing it forces a new database.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(engineVersionPattern, "must be a version of the form <major>.<minor>"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"source_database_id": schema.StringAttribute{
+				Description: "ID of a database to clone. The new database is created as a server-side copy of it, and Terraform waits for the copy to finish. Changing it forces a new database.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"source_snapshot_id": schema.StringAttribute{
+				Description: "ID of a snapshot of `source_database_id` to clone instead of its current contents. Changing it forces a new database.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("source_database_id")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"tags": schema.MapAttribute{
+				Description: "Tags to assign to the database.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"tags_all": schema.MapAttribute{
+				Description: "All tags on the database, including those inherited from the provider default_tags.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// IdentitySchema defines the identity schema for the resource.
+func (r *databaseResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"region": identityschema.StringAttribute{
+				Description:       "Region of the API endpoint serving the database. Defaults to the provider's region on import.",
+				OptionalForImport: true,
+			},
+			"id": identityschema.StringAttribute{
+				Description:       "ID of the database.",
+				RequiredForImport: true,
 			},
 		},
 	}
 }
 
+// databaseIdentityModel maps the resource identity schema data.
+type databaseIdentityModel struct {
+	Region types.String `tfsdk:"region"`
+	ID     types.String `tfsdk:"id"`
+}
+
+// newDatabaseIdentity returns the identity of the database with the given
+// ID, served by the client's endpoint.
+func newDatabaseIdentity(client *Client, id string) databaseIdentityModel {
+	region := types.StringNull()
+	if v := regionFromHost(client.HostURL); v != "" {
+		region = types.StringValue(v)
+	}
+
+	return databaseIdentityModel{
+		Region: region,
+		ID:     types.StringValue(id),
+	}
+}
+
+// ImportState imports a database by ID or by identity.
+func (r *databaseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" && req.Identity != nil {
+		var identity databaseIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		region := regionFromHost(r.client.HostURL)
+		if !identity.Region.IsNull() && identity.Region.ValueString() != region {
+			resp.Diagnostics.AddError(
+				"Mismatched Database Region",
+				fmt.Sprintf("The database identity is in region %q, but the provider is configured for region %q. "+
+					"Import the database with a provider configured for its region.", identity.Region.ValueString(), region),
+			)
+			return
+		}
+	}
+
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
 // orderResourceModel maps the resource schema data.
 type databaseResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	LastUpdated types.String `tfsdk:"last_updated"`
+	ID                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	LastUpdated        types.String `tfsdk:"last_updated"`
+	DeletionProtection types.Bool   `tfsdk:"deletion_protection"`
+	Tags               types.Map    `tfsdk:"tags"`
+	TagsAll            types.Map    `tfsdk:"tags_all"`
+	Tier               types.String `tfsdk:"tier"`
+	StorageGB          types.Int64  `tfsdk:"storage_gb"`
+	MaxItems           types.Int64  `tfsdk:"max_items"`
+	EngineVersion      types.String `tfsdk:"engine_version"`
+	CreatedAt          types.String `tfsdk:"created_at"`
+	UpdatedAt          types.String `tfsdk:"updated_at"`
+	SourceDatabaseId   types.String `tfsdk:"source_database_id"`
+	SourceSnapshotId   types.String `tfsdk:"source_snapshot_id"`
+}
+
+// newDatabaseResourceModel builds a complete resource model from an API
+// response, for use where there is no prior state such as list results.
+func newDatabaseResourceModel(ctx context.Context, database *CreateDatabaseResponse) (databaseResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	model := databaseResourceModel{
+		ID:                 types.StringValue(database.Id),
+		Name:               types.StringValue(database.Name),
+		DeletionProtection: types.BoolPointerValue(database.DeletionProtection),
+		Tags:               types.MapNull(types.StringType),
+	}
+	model.setSettings(database)
+	model.setTimestamps(database)
+	model.setSource(database)
+
+	if len(database.Tags) > 0 {
+		tags, tagsDiags := tagsToMap(ctx, database.Tags)
+		diags.Append(tagsDiags...)
+		model.Tags = tags
+	}
+
+	tagsAll, tagsDiags := tagsToMap(ctx, database.Tags)
+	diags.Append(tagsDiags...)
+	model.TagsAll = tagsAll
+
+	return model, diags
+}
+
+// setSettings maps the capacity and engine settings from an API response.
+func (m *databaseResourceModel) setSettings(database *CreateDatabaseResponse) {
+	m.Tier = types.StringValue(database.Tier)
+	m.StorageGB = types.Int64Value(database.StorageGB)
+	m.MaxItems = types.Int64Value(database.MaxItems)
+	m.EngineVersion = types.StringValue(database.EngineVersion)
+}
+
+// setSource maps the clone source from an API response. Services that do
+// not report the source leave known values unchanged.
+func (m *databaseResourceModel) setSource(database *CreateDatabaseResponse) {
+	if database.SourceDatabaseId != "" {
+		m.SourceDatabaseId = types.StringValue(database.SourceDatabaseId)
+	} else if m.SourceDatabaseId.IsUnknown() {
+		m.SourceDatabaseId = types.StringNull()
+	}
+
+	if database.SourceSnapshotId != "" {
+		m.SourceSnapshotId = types.StringValue(database.SourceSnapshotId)
+	} else if m.SourceSnapshotId.IsUnknown() {
+		m.SourceSnapshotId = types.StringNull()
+	}
+}
+
+// setTimestamps maps the server-side timestamps from an API response.
+// last_updated mirrors updated_at until it is removed.
+func (m *databaseResourceModel) setTimestamps(database *CreateDatabaseResponse) {
+	m.CreatedAt = types.StringValue(database.CreatedAt)
+	m.UpdatedAt = types.StringValue(database.UpdatedAt)
+	m.LastUpdated = types.StringValue(database.UpdatedAt)
+}
+
+// ValidateConfig checks that the configured capacity fits the tier.
+func (r *databaseResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config databaseResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Tier.IsUnknown() || config.StorageGB.IsUnknown() || config.MaxItems.IsUnknown() {
+		return
+	}
+
+	tier := defaultDatabaseTier
+	if !config.Tier.IsNull() {
+		tier = config.Tier.ValueString()
+	}
+
+	if err := validateDatabaseCapacity(tier, config.StorageGB.ValueInt64(), config.MaxItems.ValueInt64()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("tier"),
+			"Invalid Database Capacity",
+			"The configured capacity does not fit the database tier: "+err.Error(),
+		)
+	}
+}
+
+// storageShrinkRequiresReplace forces replacement when storage_gb decreases,
+// as storage can only be grown in place.
+func storageShrinkRequiresReplace(_ context.Context, req planmodifier.Int64Request, resp *int64planmodifier.RequiresReplaceIfFuncResponse) {
+	if req.StateValue.IsNull() || req.PlanValue.IsUnknown() || req.PlanValue.IsNull() {
+		return
+	}
+
+	resp.RequiresReplace = req.PlanValue.ValueInt64() < req.StateValue.ValueInt64()
+}
+
+// ModifyPlan checks that the planned capacity fits the tier, and computes
+// tags_all from the resource tags and the provider default_tags, so changes
+// to either show up in the plan.
+func (r *databaseResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	// Capacity carried over from state is not part of the configuration, so
+	// ValidateConfig cannot catch it exceeding a new tier.
+	var plan databaseResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Tier.IsUnknown() && !plan.StorageGB.IsUnknown() && !plan.MaxItems.IsUnknown() {
+		if err := validateDatabaseCapacity(plan.Tier.ValueString(), plan.StorageGB.ValueInt64(), plan.MaxItems.ValueInt64()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("tier"),
+				"Invalid Database Capacity",
+				"The planned capacity does not fit the database tier: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	// Nothing more to do before the provider is configured.
+	if r.client == nil {
+		return
+	}
+
+	if plan.Tags.IsUnknown() {
+		return
+	}
+
+	configured, diags := tagsFromMap(ctx, plan.Tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tagsAll, diags := tagsToMap(ctx, mergeTags(r.client.DefaultTags, configured))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("tags_all"), tagsAll)...)
 }
 
 // Create creates the resource and sets the initial Terraform state.
@@ -87,14 +423,36 @@ func (r *databaseResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	tags, diags := tagsFromMap(ctx, plan.Tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tagsAll := mergeTags(r.client.DefaultTags, tags)
+
 	// Generate API request body from plan
 	database_request := CreateDatabaseRequest{
-		Name: string(plan.Name.ValueString()),
+		Name:               string(plan.Name.ValueString()),
+		DeletionProtection: plan.DeletionProtection.ValueBool(),
+		Tags:               tagsAll,
+		Tier:               plan.Tier.ValueString(),
+		StorageGB:          plan.StorageGB.ValueInt64(),
+		MaxItems:           plan.MaxItems.ValueInt64(),
+		EngineVersion:      plan.EngineVersion.ValueString(),
 	}
 	// var database_request = CreateDatabaseRequest{Name: types.StringValue(plan.Name)}
 
-	// Create new database_response
-	database_response, err := r.client.CreateDatabase(database_request)
+	// Create new database_response, cloning the source database if one is set
+	var database_response *CreateDatabaseResponse
+	var err error
+	if plan.SourceDatabaseId.IsUnknown() || plan.SourceDatabaseId.IsNull() {
+		database_response, err = r.client.CreateDatabase(database_request)
+	} else {
+		database_response, err = r.client.CloneDatabase(CloneDatabaseRequest{
+			CreateDatabaseRequest: database_request,
+			SnapshotId:            plan.SourceSnapshotId.ValueString(),
+		}, plan.SourceDatabaseId.ValueString())
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating order",
@@ -103,10 +461,32 @@ func (r *databaseResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	// Clones copy their source in the background; wait for the copy to
+	// finish. A failed wait still records the database so it is tainted
+	// rather than leaked.
+	var waitErr error
+	if !isAvailable(database_response.Status) {
+		var available *CreateDatabaseResponse
+		available, waitErr = waitForDatabaseAvailable(ctx, r.client, database_response.Id)
+		if waitErr == nil {
+			database_response = available
+		}
+	}
+
 	// Map response body to schema and populate Computed attribute values
 	plan.ID = types.StringValue(database_response.Id)
 	plan.Name = types.StringValue(database_response.Name)
-	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+	plan.setSettings(database_response)
+	if database_response.DeletionProtection != nil {
+		plan.DeletionProtection = types.BoolValue(*database_response.DeletionProtection)
+	}
+	if database_response.Tags != nil {
+		tagsAll = database_response.Tags
+	}
+	plan.TagsAll, diags = tagsToMap(ctx, tagsAll)
+	resp.Diagnostics.Append(diags...)
+	plan.setTimestamps(database_response)
+	plan.setSource(database_response)
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
@@ -114,6 +494,17 @@ func (r *databaseResource) Create(ctx context.Context, req resource.CreateReques
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	if resp.Identity != nil {
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, newDatabaseIdentity(r.client, plan.ID.ValueString()))...)
+	}
+
+	if waitErr != nil {
+		resp.Diagnostics.AddError(
+			"Error Waiting for Database",
+			"Database ID "+plan.ID.ValueString()+" was created but did not become available: "+waitErr.Error(),
+		)
+	}
 }
 
 func (r *databaseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -127,6 +518,11 @@ func (r *databaseResource) Read(ctx context.Context, req resource.ReadRequest, r
 
 	// Get refreshed order value from HashiCups
 	database, err := r.client.GetDatabase(state.ID.ValueString())
+	if IsNotFound(err) {
+		// Deleted outside of Terraform; plan to create it again.
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading HashiCups Order",
@@ -137,6 +533,34 @@ func (r *databaseResource) Read(ctx context.Context, req resource.ReadRequest, r
 
 	// Overwrite items with refreshed state
 	state.Name = types.StringValue(database.Name)
+	state.setSettings(database)
+	state.setTimestamps(database)
+	state.setSource(database)
+	if database.DeletionProtection != nil {
+		state.DeletionProtection = types.BoolValue(*database.DeletionProtection)
+	}
+
+	// Only refresh the tags the configuration manages; the rest are
+	// reported through tags_all.
+	if !state.Tags.IsNull() {
+		configured, diags := tagsFromMap(ctx, state.Tags)
+		resp.Diagnostics.Append(diags...)
+
+		refreshed := make(map[string]string, len(configured))
+		for k := range configured {
+			if v, ok := database.Tags[k]; ok {
+				refreshed[k] = v
+			}
+		}
+
+		state.Tags, diags = tagsToMap(ctx, refreshed)
+		resp.Diagnostics.Append(diags...)
+	}
+	state.TagsAll, diags = tagsToMap(ctx, database.Tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
@@ -144,10 +568,77 @@ func (r *databaseResource) Read(ctx context.Context, req resource.ReadRequest, r
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	if resp.Identity != nil {
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, newDatabaseIdentity(r.client, state.ID.ValueString()))...)
+	}
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *databaseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan databaseResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The ID never changes in place, so take it from state.
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &plan.ID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tags, diags := tagsFromMap(ctx, plan.Tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tagsAll := mergeTags(r.client.DefaultTags, tags)
+
+	// Generate API request body from plan
+	database_request := UpdateDatabaseRequest{
+		Name:               plan.Name.ValueString(),
+		DeletionProtection: plan.DeletionProtection.ValueBool(),
+		Tags:               tagsAll,
+		Tier:               plan.Tier.ValueString(),
+		StorageGB:          plan.StorageGB.ValueInt64(),
+		MaxItems:           plan.MaxItems.ValueInt64(),
+	}
+
+	// Update existing database
+	database_response, err := r.client.UpdateDatabase(database_request, plan.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Database",
+			"Could not update database ID "+plan.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.Name = types.StringValue(database_response.Name)
+	plan.setSettings(database_response)
+	if database_response.DeletionProtection != nil {
+		plan.DeletionProtection = types.BoolValue(*database_response.DeletionProtection)
+	}
+	if database_response.Tags != nil {
+		tagsAll = database_response.Tags
+	}
+	plan.TagsAll, diags = tagsToMap(ctx, tagsAll)
+	resp.Diagnostics.Append(diags...)
+	plan.setTimestamps(database_response)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if resp.Identity != nil {
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, newDatabaseIdentity(r.client, plan.ID.ValueString()))...)
+	}
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -160,9 +651,20 @@ func (r *databaseResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
+	// Deletion protection is enforced by the provider as well, so it also
+	// applies when the service does not support the flag.
+	if state.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Database Deletion Protection Enabled",
+			"Database ID "+state.ID.ValueString()+" has deletion_protection enabled and cannot be destroyed. "+
+				"Set deletion_protection = false and apply that change first, then destroy the database.",
+		)
+		return
+	}
+
 	// Delete existing order
 	err := r.client.DeleteDatabase(state.ID.ValueString())
-	if err != nil {
+	if err != nil && !IsNotFound(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting HashiCups Order",
 			"Could not delete order, unexpected error: "+err.Error(),
@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ resource.ResourceWithMoveState = &databaseResource{}
+
+// legacyProviderTypes are the provider type names this provider has been
+// served under. State for their database resources can be moved into the
+// current database resource from any hostname or namespace, such as
+// hashicorp.com/edu/bobsdiscountcloudco.
+var legacyProviderTypes = []string{"bobsdiscountcloudco", "hashicups"}
+
+// MoveState returns the state movers that accept database state written by
+// earlier provider addresses and resource type names.
+func (r *databaseResource) MoveState(ctx context.Context) []resource.StateMover {
+	schemaV0 := databaseResourceSchemaV0()
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	return []resource.StateMover{
+		{
+			SourceSchema: &schemaV0,
+			StateMover:   moveDatabaseResourceStateV0,
+		},
+		{
+			SourceSchema: &schemaResp.Schema,
+			StateMover:   moveDatabaseResourceState,
+		},
+	}
+}
+
+// moveDatabaseResourceStateV0 moves version 0 state from a legacy database
+// resource, upgrading it on the way. Other sources are left for the next
+// mover.
+func moveDatabaseResourceStateV0(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+	if !isLegacyDatabaseSource(req.SourceProviderAddress, req.SourceTypeName) || req.SourceSchemaVersion != 0 {
+		return
+	}
+
+	if req.SourceState == nil {
+		resp.Diagnostics.AddError(
+			"Unable to Move Database State",
+			"The "+req.SourceTypeName+" resource state from "+req.SourceProviderAddress+" could not be decoded as a database.",
+		)
+		return
+	}
+
+	var prior databaseResourceModelV0
+	resp.Diagnostics.Append(req.SourceState.Get(ctx, &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The identity is left unset; the next refresh records it, since the
+	// resource is not configured with a client while moving state.
+	resp.Diagnostics.Append(resp.TargetState.Set(ctx, upgradeDatabaseResourceModelV0(prior))...)
+}
+
+// moveDatabaseResourceState moves state of the current schema version from
+// a legacy database resource. Other sources are left for the next mover.
+func moveDatabaseResourceState(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+	if !isLegacyDatabaseSource(req.SourceProviderAddress, req.SourceTypeName) || req.SourceSchemaVersion == 0 {
+		return
+	}
+
+	if req.SourceSchemaVersion > databaseResourceSchemaVersion {
+		resp.Diagnostics.AddError(
+			"Unable to Move Database State",
+			fmt.Sprintf("The %s resource state has schema version %d, which is newer than the version %d supported by this provider. Upgrade the provider and try again.",
+				req.SourceTypeName, req.SourceSchemaVersion, databaseResourceSchemaVersion),
+		)
+		return
+	}
+
+	if req.SourceState == nil {
+		resp.Diagnostics.AddError(
+			"Unable to Move Database State",
+			"The "+req.SourceTypeName+" resource state from "+req.SourceProviderAddress+" could not be decoded as a database.",
+		)
+		return
+	}
+
+	var state databaseResourceModel
+	resp.Diagnostics.Append(req.SourceState.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.TargetState.Set(ctx, state)...)
+}
+
+// isLegacyDatabaseSource reports whether typeName at providerAddress is a
+// database resource of a legacy provider address.
+func isLegacyDatabaseSource(providerAddress, typeName string) bool {
+	providerType := providerAddress[strings.LastIndex(providerAddress, "/")+1:]
+
+	for _, legacy := range legacyProviderTypes {
+		if providerType == legacy && typeName == legacy+"_database" {
+			return true
+		}
+	}
+
+	return false
+}
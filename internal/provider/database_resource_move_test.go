@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestDatabaseResourceMoveState(t *testing.T) {
+	cases := map[string]struct {
+		providerAddress string
+		typeName        string
+		schemaVersion   int64
+		fixture         string
+		expectMoved     bool
+		expectError     bool
+		expectUpdatedAt string
+	}{
+		"edu address v0": {
+			providerAddress: "hashicorp.com/edu/bobsdiscountcloudco",
+			typeName:        "bobsdiscountcloudco_database",
+			schemaVersion:   0,
+			fixture:         "database_resource_v0_original.json",
+			expectMoved:     true,
+			expectUpdatedAt: "2006-01-02T15:04:05Z",
+		},
+		"edu address v1": {
+			providerAddress: "hashicorp.com/edu/bobsdiscountcloudco",
+			typeName:        "bobsdiscountcloudco_database",
+			schemaVersion:   1,
+			fixture:         "database_resource_v0_tagged.json",
+			expectMoved:     true,
+			expectUpdatedAt: "2025-06-01T12:00:00Z",
+		},
+		"hashicups type": {
+			providerAddress: "registry.terraform.io/hashicorp/hashicups",
+			typeName:        "hashicups_database",
+			schemaVersion:   0,
+			fixture:         "database_resource_v0_original.json",
+			expectMoved:     true,
+			expectUpdatedAt: "2006-01-02T15:04:05Z",
+		},
+		"unrelated type": {
+			providerAddress: "hashicorp.com/edu/bobsdiscountcloudco",
+			typeName:        "bobsdiscountcloudco_database_item",
+			fixture:         "database_resource_v0_original.json",
+		},
+		"unrelated provider": {
+			providerAddress: "registry.terraform.io/hashicorp/aws",
+			typeName:        "aws_database",
+			fixture:         "database_resource_v0_original.json",
+		},
+		"newer schema version": {
+			providerAddress: "hashicorp.com/edu/bobsdiscountcloudco",
+			typeName:        "bobsdiscountcloudco_database",
+			schemaVersion:   databaseResourceSchemaVersion + 1,
+			fixture:         "database_resource_v0_tagged.json",
+			expectError:     true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			r := &databaseResource{}
+
+			raw, err := os.ReadFile(filepath.Join("testdata", tc.fixture))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			schemaResp := &resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+			targetType := schemaResp.Schema.Type().TerraformType(ctx)
+
+			resp := &resource.MoveStateResponse{
+				TargetState: tfsdk.State{
+					Raw:    tftypes.NewValue(targetType, nil),
+					Schema: schemaResp.Schema,
+				},
+			}
+
+			// Call each mover in turn until one sets the state or errors,
+			// as Terraform does.
+			for _, mover := range r.MoveState(ctx) {
+				sourceValue, err := tftypes.ValueFromJSONWithOpts(raw, mover.SourceSchema.Type().TerraformType(ctx), tftypes.ValueFromJSONOpts{
+					IgnoreUndefinedAttributes: true,
+				})
+				if err != nil {
+					t.Fatalf("decoding fixture: %s", err)
+				}
+
+				req := resource.MoveStateRequest{
+					SourceProviderAddress: tc.providerAddress,
+					SourceTypeName:        tc.typeName,
+					SourceSchemaVersion:   tc.schemaVersion,
+					SourceState:           &tfsdk.State{Raw: sourceValue, Schema: *mover.SourceSchema},
+				}
+
+				mover.StateMover(ctx, req, resp)
+				if resp.Diagnostics.HasError() || !resp.TargetState.Raw.IsNull() {
+					break
+				}
+			}
+
+			if got := resp.Diagnostics.HasError(); got != tc.expectError {
+				t.Fatalf("got error %t, want %t: %v", got, tc.expectError, resp.Diagnostics)
+			}
+
+			if moved := !resp.TargetState.Raw.IsNull(); moved != tc.expectMoved {
+				t.Fatalf("got moved %t, want %t", moved, tc.expectMoved)
+			}
+
+			if !tc.expectMoved {
+				return
+			}
+
+			var state databaseResourceModel
+			if diags := resp.TargetState.Get(ctx, &state); diags.HasError() {
+				t.Fatalf("unexpected diagnostics reading moved state: %v", diags)
+			}
+
+			if got, want := state.ID.ValueString(), "db-0123abcd"; got != want {
+				t.Errorf("id: got %q, want %q", got, want)
+			}
+			if got := state.UpdatedAt.ValueString(); got != tc.expectUpdatedAt {
+				t.Errorf("updated_at: got %q, want %q", got, tc.expectUpdatedAt)
+			}
+		})
+	}
+}
@@ -0,0 +1,469 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccDatabaseResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccDatabaseResourceConfig("tf-acc-basic", "deletion_protection = false"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"bobsdiscountcloudco_database.test",
+						tfjsonpath.New("id"),
+						knownvalue.NotNull(),
+					),
+					statecheck.ExpectKnownValue(
+						"bobsdiscountcloudco_database.test",
+						tfjsonpath.New("name"),
+						knownvalue.StringExact("tf-acc-basic"),
+					),
+					statecheck.ExpectKnownValue(
+						"bobsdiscountcloudco_database.test",
+						tfjsonpath.New("tier"),
+						knownvalue.StringExact("standard"),
+					),
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:      "bobsdiscountcloudco_database.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Renaming and changing tier are in-place updates
+			{
+				Config: testAccDatabaseResourceConfig("tf-acc-basic-renamed", "deletion_protection = false\n  tier = \"premium\""),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("bobsdiscountcloudco_database.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"bobsdiscountcloudco_database.test",
+						tfjsonpath.New("name"),
+						knownvalue.StringExact("tf-acc-basic-renamed"),
+					),
+					statecheck.ExpectKnownValue(
+						"bobsdiscountcloudco_database.test",
+						tfjsonpath.New("tier"),
+						knownvalue.StringExact("premium"),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestAccDatabaseResource_Disappears(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// A database deleted outside of Terraform is planned for creation
+			{
+				Config:             testAccDatabaseResourceConfig("tf-acc-disappears", "deletion_protection = false"),
+				Check:              testAccCheckDatabaseDisappears("bobsdiscountcloudco_database.test"),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccDatabaseResourceConfig("tf-acc-disappears", "deletion_protection = false"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("bobsdiscountcloudco_database.test", plancheck.ResourceActionCreate),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccDatabaseResource_DeletionProtection(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Protection defaults to enabled
+			{
+				Config: testAccDatabaseResourceConfig("tf-acc-protected", ""),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"bobsdiscountcloudco_database.test",
+						tfjsonpath.New("deletion_protection"),
+						knownvalue.Bool(true),
+					),
+				},
+			},
+			// Destroying a protected database fails
+			{
+				Config:      testAccDatabaseResourceConfig("tf-acc-protected", ""),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`Database Deletion Protection Enabled`),
+			},
+			// Disabling protection is an in-place update, after which the
+			// database can be destroyed
+			{
+				Config: testAccDatabaseResourceConfig("tf-acc-protected", "deletion_protection = false"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"bobsdiscountcloudco_database.test",
+						tfjsonpath.New("deletion_protection"),
+						knownvalue.Bool(false),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestAccDatabaseResource_Tags(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseResourceConfigTags("dev"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"bobsdiscountcloudco_database.test",
+						tfjsonpath.New("tags"),
+						knownvalue.MapExact(map[string]knownvalue.Check{
+							"environment": knownvalue.StringExact("dev"),
+						}),
+					),
+					statecheck.ExpectKnownValue(
+						"bobsdiscountcloudco_database.test",
+						tfjsonpath.New("tags_all"),
+						knownvalue.MapExact(map[string]knownvalue.Check{
+							"owner":       knownvalue.StringExact("platform"),
+							"environment": knownvalue.StringExact("dev"),
+						}),
+					),
+				},
+			},
+			{
+				Config: testAccDatabaseResourceConfigTags("prod"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"bobsdiscountcloudco_database.test",
+						tfjsonpath.New("tags_all").AtMapKey("environment"),
+						knownvalue.StringExact("prod"),
+					),
+					statecheck.ExpectKnownValue(
+						"data.bobsdiscountcloudco_databases.test",
+						tfjsonpath.New("databases"),
+						knownvalue.ListSizeExact(1),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestAccDatabaseResource_Capacity(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDatabaseResourceConfig("tf-acc-capacity", "tier = \"free\"\n  storage_gb = 10"),
+				ExpectError: regexp.MustCompile(`the free tier allows at most 1 GB of storage`),
+			},
+			{
+				Config: testAccDatabaseResourceConfig("tf-acc-capacity", "deletion_protection = false\n  storage_gb = 10"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"bobsdiscountcloudco_database.test",
+						tfjsonpath.New("tier"),
+						knownvalue.StringExact("standard"),
+					),
+				},
+			},
+			// Growing storage is an in-place update
+			{
+				Config: testAccDatabaseResourceConfig("tf-acc-capacity", "deletion_protection = false\n  storage_gb = 20"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("bobsdiscountcloudco_database.test", plancheck.ResourceActionUpdate),
+					},
+				},
+			},
+			// Shrinking storage replaces the database
+			{
+				Config: testAccDatabaseResourceConfig("tf-acc-capacity", "deletion_protection = false\n  storage_gb = 5"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("bobsdiscountcloudco_database.test", plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccDatabaseResource_TierChange(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseResourceConfig("tf-acc-tier", "deletion_protection = false"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"bobsdiscountcloudco_database.test",
+						tfjsonpath.New("max_items"),
+						knownvalue.Int64Exact(1000000),
+					),
+				},
+			},
+			// Unconfigured capacity follows the tier
+			{
+				Config: testAccDatabaseResourceConfig("tf-acc-tier", "deletion_protection = false\n  tier = \"premium\""),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("bobsdiscountcloudco_database.test", plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue("bobsdiscountcloudco_database.test", tfjsonpath.New("storage_gb"), knownvalue.Int64Exact(100)),
+						plancheck.ExpectKnownValue("bobsdiscountcloudco_database.test", tfjsonpath.New("max_items"), knownvalue.Int64Exact(100000000)),
+					},
+				},
+			},
+			// Moving to a smaller tier shrinks storage, which replaces the
+			// database
+			{
+				Config: testAccDatabaseResourceConfig("tf-acc-tier", "deletion_protection = false\n  tier = \"free\""),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("bobsdiscountcloudco_database.test", plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"bobsdiscountcloudco_database.test",
+						tfjsonpath.New("storage_gb"),
+						knownvalue.Int64Exact(1),
+					),
+					statecheck.ExpectKnownValue(
+						"bobsdiscountcloudco_database.test",
+						tfjsonpath.New("max_items"),
+						knownvalue.Int64Exact(1000),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestAccDatabaseResource_Timestamps(t *testing.T) {
+	rfc3339 := regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}(\.\d+)?(Z|[+-]\d{2}:\d{2})$`)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseResourceConfig("tf-acc-timestamps", "deletion_protection = false"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"bobsdiscountcloudco_database.test",
+						tfjsonpath.New("created_at"),
+						knownvalue.StringRegexp(rfc3339),
+					),
+					statecheck.ExpectKnownValue(
+						"bobsdiscountcloudco_database.test",
+						tfjsonpath.New("updated_at"),
+						knownvalue.StringRegexp(rfc3339),
+					),
+					statecheck.CompareValuePairs(
+						"bobsdiscountcloudco_database.test",
+						tfjsonpath.New("last_updated"),
+						"bobsdiscountcloudco_database.test",
+						tfjsonpath.New("updated_at"),
+						compare.ValuesSame(),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestAccDatabaseResource_Identity(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		// Resource identity is only available in 1.12 and later
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseResourceConfig("tf-acc-identity", "deletion_protection = false"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentityValueMatchesState(
+						"bobsdiscountcloudco_database.test",
+						tfjsonpath.New("id"),
+					),
+				},
+			},
+			{
+				ResourceName:    "bobsdiscountcloudco_database.test",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
+func TestAccDatabaseResource_Clone(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseResourceConfigClone("tf-acc-clone-source", "source"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.CompareValuePairs(
+						"bobsdiscountcloudco_database.clone",
+						tfjsonpath.New("source_database_id"),
+						"bobsdiscountcloudco_database.source",
+						tfjsonpath.New("id"),
+						compare.ValuesSame(),
+					),
+					statecheck.ExpectKnownValue(
+						"bobsdiscountcloudco_database.clone",
+						tfjsonpath.New("source_snapshot_id"),
+						knownvalue.Null(),
+					),
+				},
+			},
+			// Renaming the source updates it in place and leaves the clone
+			// alone
+			{
+				Config: testAccDatabaseResourceConfigClone("tf-acc-clone-renamed", "source"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("bobsdiscountcloudco_database.source", plancheck.ResourceActionUpdate),
+						plancheck.ExpectResourceAction("bobsdiscountcloudco_database.clone", plancheck.ResourceActionNoop),
+					},
+				},
+			},
+			// Cloning a different database replaces the clone
+			{
+				Config: testAccDatabaseResourceConfigClone("tf-acc-clone-renamed", "other"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("bobsdiscountcloudco_database.clone", plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.CompareValuePairs(
+						"bobsdiscountcloudco_database.clone",
+						tfjsonpath.New("source_database_id"),
+						"bobsdiscountcloudco_database.other",
+						tfjsonpath.New("id"),
+						compare.ValuesSame(),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccDatabaseResourceConfig(name, extra string) string {
+	return testAccProviderConfig() + fmt.Sprintf(`
+resource "bobsdiscountcloudco_database" "test" {
+  name = %[1]q
+  %[2]s
+}
+`, name, extra)
+}
+
+func testAccDatabaseResourceConfigTags(environment string) string {
+	return fmt.Sprintf(`
+provider "bobsdiscountcloudco" {
+  host    = %[1]q
+  api_key = %[2]q
+
+  default_tags = {
+    owner = "platform"
+  }
+}
+
+resource "bobsdiscountcloudco_database" "test" {
+  name                = "tf-acc-tags"
+  deletion_protection = false
+
+  tags = {
+    environment = %[3]q
+  }
+}
+
+data "bobsdiscountcloudco_databases" "test" {
+  tags = {
+    environment = %[3]q
+  }
+
+  depends_on = [bobsdiscountcloudco_database.test]
+}
+`, os.Getenv("HASHICUPS_HOST"), os.Getenv("HASHICUPS_API_KEY"), environment)
+}
+
+// testAccDatabaseResourceConfigClone configures two databases, source and
+// other, and a clone of the one named by cloneOf.
+func testAccDatabaseResourceConfigClone(sourceName, cloneOf string) string {
+	return testAccProviderConfig() + fmt.Sprintf(`
+resource "bobsdiscountcloudco_database" "source" {
+  name                = %[1]q
+  deletion_protection = false
+}
+
+resource "bobsdiscountcloudco_database" "other" {
+  name                = "tf-acc-clone-other"
+  deletion_protection = false
+}
+
+resource "bobsdiscountcloudco_database" "clone" {
+  name                = "tf-acc-clone"
+  deletion_protection = false
+  source_database_id  = bobsdiscountcloudco_database.%[2]s.id
+}
+`, sourceName, cloneOf)
+}
+
+// testAccCheckDatabaseDisappears deletes the database of a resource outside
+// of Terraform.
+func testAccCheckDatabaseDisappears(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource %s not found in state", name)
+		}
+
+		client, err := testAccClient()
+		if err != nil {
+			return err
+		}
+
+		return client.DeleteDatabase(rs.Primary.ID)
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// databaseResourceSchemaVersion is the current version of the database
+// resource schema. Bump it, add the previous schema below and register an
+// upgrader in UpgradeState whenever stored state needs to be migrated.
+//
+//   - 0: last_updated held a provider-side RFC 850 timestamp.
+//   - 1: last_updated mirrors the server-side updated_at (RFC 3339).
+const databaseResourceSchemaVersion = 1
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ resource.ResourceWithUpgradeState = &databaseResource{}
+
+// databaseResourceSchemaV0 is the database resource schema at version 0.
+// Only the attribute types matter for decoding prior state.
+func databaseResourceSchemaV0() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":                  schema.StringAttribute{Computed: true},
+			"last_updated":        schema.StringAttribute{Computed: true},
+			"created_at":          schema.StringAttribute{Computed: true},
+			"updated_at":          schema.StringAttribute{Computed: true},
+			"name":                schema.StringAttribute{Required: true},
+			"deletion_protection": schema.BoolAttribute{Optional: true, Computed: true},
+			"tier":                schema.StringAttribute{Optional: true, Computed: true},
+			"storage_gb":          schema.Int64Attribute{Optional: true, Computed: true},
+			"max_items":           schema.Int64Attribute{Optional: true, Computed: true},
+			"engine_version":      schema.StringAttribute{Optional: true, Computed: true},
+			"tags":                schema.MapAttribute{ElementType: types.StringType, Optional: true},
+			"tags_all":            schema.MapAttribute{ElementType: types.StringType, Computed: true},
+		},
+	}
+}
+
+// UpgradeState returns the upgraders from each prior schema version to the
+// current version.
+func (r *databaseResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	schemaV0 := databaseResourceSchemaV0()
+
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema:   &schemaV0,
+			StateUpgrader: upgradeDatabaseResourceStateV0toV1,
+		},
+	}
+}
+
+// databaseResourceModelV0 maps the version 0 schema data.
+type databaseResourceModelV0 struct {
+	ID                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	LastUpdated        types.String `tfsdk:"last_updated"`
+	DeletionProtection types.Bool   `tfsdk:"deletion_protection"`
+	Tags               types.Map    `tfsdk:"tags"`
+	TagsAll            types.Map    `tfsdk:"tags_all"`
+	Tier               types.String `tfsdk:"tier"`
+	StorageGB          types.Int64  `tfsdk:"storage_gb"`
+	MaxItems           types.Int64  `tfsdk:"max_items"`
+	EngineVersion      types.String `tfsdk:"engine_version"`
+	CreatedAt          types.String `tfsdk:"created_at"`
+	UpdatedAt          types.String `tfsdk:"updated_at"`
+}
+
+// upgradeDatabaseResourceStateV0toV1 converts last_updated from RFC 850 to
+// RFC 3339 and backfills updated_at from it for state written before the
+// server-side timestamps existed.
+func upgradeDatabaseResourceStateV0toV1(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var prior databaseResourceModelV0
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, upgradeDatabaseResourceModelV0(prior))...)
+}
+
+// upgradeDatabaseResourceModelV0 converts a version 0 model to the current
+// model.
+func upgradeDatabaseResourceModelV0(prior databaseResourceModelV0) databaseResourceModel {
+	state := databaseResourceModel{
+		ID:                 prior.ID,
+		Name:               prior.Name,
+		LastUpdated:        prior.LastUpdated,
+		DeletionProtection: prior.DeletionProtection,
+		Tags:               prior.Tags,
+		TagsAll:            prior.TagsAll,
+		Tier:               prior.Tier,
+		StorageGB:          prior.StorageGB,
+		MaxItems:           prior.MaxItems,
+		EngineVersion:      prior.EngineVersion,
+		CreatedAt:          prior.CreatedAt,
+		UpdatedAt:          prior.UpdatedAt,
+		SourceDatabaseId:   types.StringNull(),
+		SourceSnapshotId:   types.StringNull(),
+	}
+
+	if lastUpdated, err := time.Parse(time.RFC850, state.LastUpdated.ValueString()); err == nil {
+		state.LastUpdated = types.StringValue(lastUpdated.UTC().Format(time.RFC3339))
+	}
+
+	if state.UpdatedAt.IsNull() && !state.LastUpdated.IsNull() {
+		state.UpdatedAt = state.LastUpdated
+	}
+
+	return state
+}
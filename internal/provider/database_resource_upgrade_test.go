@@ -0,0 +1,130 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestDatabaseResourceUpgradeState(t *testing.T) {
+	cases := map[string]struct {
+		fixture string
+		check   func(t *testing.T, state databaseResourceModel)
+	}{
+		"original": {
+			fixture: "database_resource_v0_original.json",
+			check: func(t *testing.T, state databaseResourceModel) {
+				if got, want := state.LastUpdated.ValueString(), "2006-01-02T15:04:05Z"; got != want {
+					t.Errorf("last_updated: got %q, want %q", got, want)
+				}
+				if got, want := state.UpdatedAt.ValueString(), "2006-01-02T15:04:05Z"; got != want {
+					t.Errorf("updated_at: got %q, want %q", got, want)
+				}
+				if !state.CreatedAt.IsNull() {
+					t.Errorf("created_at: got %s, want null", state.CreatedAt)
+				}
+				if !state.DeletionProtection.IsNull() {
+					t.Errorf("deletion_protection: got %s, want null", state.DeletionProtection)
+				}
+			},
+		},
+		"tagged": {
+			fixture: "database_resource_v0_tagged.json",
+			check: func(t *testing.T, state databaseResourceModel) {
+				if got, want := state.LastUpdated.ValueString(), "2025-06-01T12:00:00Z"; got != want {
+					t.Errorf("last_updated: got %q, want %q", got, want)
+				}
+				if got, want := state.CreatedAt.ValueString(), "2025-05-01T08:30:00Z"; got != want {
+					t.Errorf("created_at: got %q, want %q", got, want)
+				}
+				if got, want := state.StorageGB.ValueInt64(), int64(250); got != want {
+					t.Errorf("storage_gb: got %d, want %d", got, want)
+				}
+				if got, want := len(state.TagsAll.Elements()), 2; got != want {
+					t.Errorf("tags_all: got %d elements, want %d", got, want)
+				}
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			r := &databaseResource{}
+
+			upgrader, ok := r.UpgradeState(ctx)[0]
+			if !ok {
+				t.Fatal("no upgrader registered for schema version 0")
+			}
+
+			raw, err := os.ReadFile(filepath.Join("testdata", tc.fixture))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			priorValue, err := tftypes.ValueFromJSON(raw, upgrader.PriorSchema.Type().TerraformType(ctx))
+			if err != nil {
+				t.Fatalf("decoding fixture: %s", err)
+			}
+
+			schemaResp := &resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+			req := resource.UpgradeStateRequest{
+				State: &tfsdk.State{Raw: priorValue, Schema: *upgrader.PriorSchema},
+			}
+			resp := &resource.UpgradeStateResponse{
+				State: tfsdk.State{
+					Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+					Schema: schemaResp.Schema,
+				},
+			}
+
+			upgrader.StateUpgrader(ctx, req, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			var state databaseResourceModel
+			if diags := resp.State.Get(ctx, &state); diags.HasError() {
+				t.Fatalf("unexpected diagnostics reading upgraded state: %v", diags)
+			}
+
+			if got, want := state.ID.ValueString(), "db-0123abcd"; got != want {
+				t.Errorf("id: got %q, want %q", got, want)
+			}
+			if got, want := state.Name.ValueString(), "orders"; got != want {
+				t.Errorf("name: got %q, want %q", got, want)
+			}
+
+			tc.check(t, state)
+		})
+	}
+}
+
+func TestDatabaseResourceSchemaVersion(t *testing.T) {
+	ctx := context.Background()
+	r := &databaseResource{}
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	if got := schemaResp.Schema.Version; got != databaseResourceSchemaVersion {
+		t.Fatalf("schema version: got %d, want %d", got, databaseResourceSchemaVersion)
+	}
+
+	upgraders := r.UpgradeState(ctx)
+	for version := int64(0); version < databaseResourceSchemaVersion; version++ {
+		if _, ok := upgraders[version]; !ok {
+			t.Errorf("no upgrader registered for schema version %d", version)
+		}
+	}
+}
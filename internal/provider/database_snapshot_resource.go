@@ -0,0 +1,341 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Snapshot retention limits, in days.
+const (
+	defaultSnapshotRetentionDays = 7
+	maxSnapshotRetentionDays     = 365
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &databaseSnapshotResource{}
+	_ resource.ResourceWithConfigure   = &databaseSnapshotResource{}
+	_ resource.ResourceWithIdentity    = &databaseSnapshotResource{}
+	_ resource.ResourceWithImportState = &databaseSnapshotResource{}
+)
+
+// NewDatabaseSnapshotResource is a helper function to simplify the provider implementation.
+func NewDatabaseSnapshotResource() resource.Resource {
+	return &databaseSnapshotResource{}
+}
+
+// databaseSnapshotResource is the resource implementation.
+type databaseSnapshotResource struct {
+	client *Client
+}
+
+// databaseSnapshotResourceModel maps the resource schema data.
+type databaseSnapshotResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	DatabaseId    types.String `tfsdk:"database_id"`
+	Description   types.String `tfsdk:"description"`
+	RetentionDays types.Int64  `tfsdk:"retention_days"`
+	Status        types.String `tfsdk:"status"`
+	CreatedAt     types.String `tfsdk:"created_at"`
+	ExpiresAt     types.String `tfsdk:"expires_at"`
+}
+
+// databaseSnapshotIdentityModel maps the resource identity schema data.
+type databaseSnapshotIdentityModel struct {
+	DatabaseId types.String `tfsdk:"database_id"`
+	ID         types.String `tfsdk:"id"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *databaseSnapshotResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Metadata returns the resource type name.
+func (r *databaseSnapshotResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_database_snapshot"
+}
+
+// Schema defines the schema for the resource.
+func (r *databaseSnapshotResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a point-in-time snapshot of a database. Terraform waits for the snapshot to finish before continuing.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the snapshot.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"database_id": schema.StringAttribute{
+				Description: "ID of the database to snapshot. Changing it forces a new snapshot.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Free-form description of the snapshot.",
+				Optional:    true,
+			},
+			"retention_days": schema.Int64Attribute{
+				Description: fmt.Sprintf("Number of days to keep the snapshot after it is created, between 1 and %d. Defaults to %d. Can be changed in place.", maxSnapshotRetentionDays, defaultSnapshotRetentionDays),
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(defaultSnapshotRetentionDays),
+				Validators: []validator.Int64{
+					int64validator.Between(1, maxSnapshotRetentionDays),
+				},
+			},
+			"status": schema.StringAttribute{
+				Description: "Status of the snapshot, e.g. `available`.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Description: "RFC 3339 timestamp at which the snapshot was taken.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"expires_at": schema.StringAttribute{
+				Description: "RFC 3339 timestamp after which the snapshot is deleted by the service.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// IdentitySchema defines the identity schema for the resource.
+func (r *databaseSnapshotResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"database_id": identityschema.StringAttribute{
+				Description:       "ID of the database the snapshot belongs to.",
+				RequiredForImport: true,
+			},
+			"id": identityschema.StringAttribute{
+				Description:       "ID of the snapshot.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *databaseSnapshotResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan databaseSnapshotResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	snapshot, err := r.client.CreateDatabaseSnapshot(CreateDatabaseSnapshotRequest{
+		Description:   plan.Description.ValueString(),
+		RetentionDays: plan.RetentionDays.ValueInt64(),
+	}, plan.DatabaseId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Database Snapshot",
+			"Could not snapshot database ID "+plan.DatabaseId.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// A failed wait still records the snapshot so it is tainted rather
+	// than leaked.
+	available, waitErr := waitForSnapshotAvailable(ctx, r.client, plan.DatabaseId.ValueString(), snapshot.Id)
+	if waitErr == nil {
+		snapshot = available
+	}
+
+	plan.setSnapshot(snapshot)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.setIdentity(ctx, resp.Identity, plan)...)
+
+	if waitErr != nil {
+		resp.Diagnostics.AddError(
+			"Error Waiting for Database Snapshot",
+			"Snapshot ID "+snapshot.Id+" was created but did not become available: "+waitErr.Error(),
+		)
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *databaseSnapshotResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state databaseSnapshotResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	snapshot, err := r.client.GetDatabaseSnapshot(state.DatabaseId.ValueString(), state.ID.ValueString())
+	if IsNotFound(err) {
+		// Deleted outside of Terraform, or expired; plan to create it again.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Database Snapshot",
+			"Could not read snapshot ID "+state.ID.ValueString()+" of database ID "+state.DatabaseId.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.setSnapshot(snapshot)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.setIdentity(ctx, resp.Identity, state)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *databaseSnapshotResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan databaseSnapshotResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	snapshot, err := r.client.UpdateDatabaseSnapshot(UpdateDatabaseSnapshotRequest{
+		Description:   plan.Description.ValueString(),
+		RetentionDays: plan.RetentionDays.ValueInt64(),
+	}, plan.DatabaseId.ValueString(), plan.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Database Snapshot",
+			"Could not update snapshot ID "+plan.ID.ValueString()+" of database ID "+plan.DatabaseId.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.setSnapshot(snapshot)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.setIdentity(ctx, resp.Identity, plan)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *databaseSnapshotResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state databaseSnapshotResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteDatabaseSnapshot(state.DatabaseId.ValueString(), state.ID.ValueString())
+	if err != nil && !IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting Database Snapshot",
+			"Could not delete snapshot ID "+state.ID.ValueString()+" of database ID "+state.DatabaseId.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports a snapshot by an ID of the form
+// <database_id>/<snapshot_id> or by identity.
+func (r *databaseSnapshotResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var identity databaseSnapshotIdentityModel
+
+	if req.ID != "" {
+		database_id, snapshot_id, ok := strings.Cut(req.ID, "/")
+		if !ok || database_id == "" || snapshot_id == "" {
+			resp.Diagnostics.AddError(
+				"Invalid Import ID",
+				fmt.Sprintf("Expected an import ID of the form <database_id>/<snapshot_id>, got %q.", req.ID),
+			)
+			return
+		}
+
+		identity.DatabaseId = types.StringValue(database_id)
+		identity.ID = types.StringValue(snapshot_id)
+	} else if req.Identity != nil {
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("database_id"), identity.DatabaseId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), identity.ID)...)
+	if resp.Identity != nil {
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, identity)...)
+	}
+}
+
+// setSnapshot maps an API response onto the model. An empty description
+// is kept null when none is configured.
+func (m *databaseSnapshotResourceModel) setSnapshot(snapshot *DatabaseSnapshot) {
+	m.ID = types.StringValue(snapshot.Id)
+	if snapshot.Description != "" || !m.Description.IsNull() {
+		m.Description = types.StringValue(snapshot.Description)
+	}
+	m.RetentionDays = types.Int64Value(snapshot.RetentionDays)
+	m.Status = types.StringValue(snapshot.Status)
+	m.CreatedAt = types.StringValue(snapshot.CreatedAt)
+	m.ExpiresAt = types.StringValue(snapshot.ExpiresAt)
+}
+
+// setIdentity stores the identity of a snapshot, when Terraform supports it.
+func (r *databaseSnapshotResource) setIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, snapshot databaseSnapshotResourceModel) diag.Diagnostics {
+	if identity == nil {
+		return nil
+	}
+
+	return identity.Set(ctx, databaseSnapshotIdentityModel{
+		DatabaseId: snapshot.DatabaseId,
+		ID:         snapshot.ID,
+	})
+}
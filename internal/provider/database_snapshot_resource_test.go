@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccDatabaseSnapshotResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccDatabaseSnapshotResourceConfig(7),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"bobsdiscountcloudco_database_snapshot.test",
+						tfjsonpath.New("status"),
+						knownvalue.StringExact("available"),
+					),
+					statecheck.ExpectKnownValue(
+						"bobsdiscountcloudco_database_snapshot.test",
+						tfjsonpath.New("retention_days"),
+						knownvalue.Int64Exact(7),
+					),
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:      "bobsdiscountcloudco_database_snapshot.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources["bobsdiscountcloudco_database_snapshot.test"]
+					if !ok {
+						return "", fmt.Errorf("snapshot not found in state")
+					}
+
+					return rs.Primary.Attributes["database_id"] + "/" + rs.Primary.ID, nil
+				},
+			},
+			// Retention is updated in place
+			{
+				Config: testAccDatabaseSnapshotResourceConfig(30),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("bobsdiscountcloudco_database_snapshot.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"bobsdiscountcloudco_database_snapshot.test",
+						tfjsonpath.New("retention_days"),
+						knownvalue.Int64Exact(30),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccDatabaseSnapshotResourceConfig(retentionDays int) string {
+	return testAccProviderConfig() + fmt.Sprintf(`
+resource "bobsdiscountcloudco_database" "test" {
+  name                = "tf-acc-snapshot"
+  deletion_protection = false
+}
+
+resource "bobsdiscountcloudco_database_snapshot" "test" {
+  database_id    = bobsdiscountcloudco_database.test.id
+  description    = "acceptance test"
+  retention_days = %[1]d
+}
+`, retentionDays)
+}
@@ -0,0 +1,203 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &databaseSnapshotsDataSource{}
+	_ datasource.DataSourceWithConfigure = &databaseSnapshotsDataSource{}
+)
+
+// NewDatabaseSnapshotsDataSource is a helper function to simplify the provider implementation.
+func NewDatabaseSnapshotsDataSource() datasource.DataSource {
+	return &databaseSnapshotsDataSource{}
+}
+
+// databaseSnapshotsDataSource is the data source implementation.
+type databaseSnapshotsDataSource struct {
+	client *Client
+}
+
+// databaseSnapshotsDataSourceModel maps the data source schema data.
+type databaseSnapshotsDataSourceModel struct {
+	DatabaseId types.String            `tfsdk:"database_id"`
+	Snapshots  []databaseSnapshotModel `tfsdk:"snapshots"`
+	MostRecent *databaseSnapshotModel  `tfsdk:"most_recent"`
+}
+
+// databaseSnapshotModel maps snapshot schema data.
+type databaseSnapshotModel struct {
+	Id            types.String `tfsdk:"id"`
+	Description   types.String `tfsdk:"description"`
+	Status        types.String `tfsdk:"status"`
+	RetentionDays types.Int64  `tfsdk:"retention_days"`
+	CreatedAt     types.String `tfsdk:"created_at"`
+	ExpiresAt     types.String `tfsdk:"expires_at"`
+}
+
+// Metadata returns the data source type name.
+func (d *databaseSnapshotsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_database_snapshots"
+}
+
+// Schema defines the schema for the data source.
+func (d *databaseSnapshotsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	snapshotAttributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed: true,
+		},
+		"description": schema.StringAttribute{
+			Computed: true,
+		},
+		"status": schema.StringAttribute{
+			Computed: true,
+		},
+		"retention_days": schema.Int64Attribute{
+			Computed: true,
+		},
+		"created_at": schema.StringAttribute{
+			Computed: true,
+		},
+		"expires_at": schema.StringAttribute{
+			Computed: true,
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Lists the snapshots of a database.",
+		Attributes: map[string]schema.Attribute{
+			"database_id": schema.StringAttribute{
+				Description: "ID of the database whose snapshots are listed.",
+				Required:    true,
+			},
+			"snapshots": schema.ListNestedAttribute{
+				Description: "Snapshots of the database, oldest first.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: snapshotAttributes,
+				},
+			},
+			"most_recent": schema.SingleNestedAttribute{
+				Description: "The most recently created snapshot that is available, or null if there is none.",
+				Computed:    true,
+				Attributes:  snapshotAttributes,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *databaseSnapshotsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *databaseSnapshotsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state databaseSnapshotsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	snapshots, err := d.client.ListAllDatabaseSnapshots(state.DatabaseId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to List Database Snapshots",
+			"Could not list snapshots of database ID "+state.DatabaseId.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	sortSnapshots(snapshots)
+
+	state.Snapshots = []databaseSnapshotModel{}
+	for _, snapshot := range snapshots {
+		state.Snapshots = append(state.Snapshots, newDatabaseSnapshotModel(snapshot))
+	}
+
+	if snapshot := mostRecentSnapshot(snapshots); snapshot != nil {
+		model := newDatabaseSnapshotModel(*snapshot)
+		state.MostRecent = &model
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// newDatabaseSnapshotModel maps a snapshot from the API.
+func newDatabaseSnapshotModel(snapshot DatabaseSnapshot) databaseSnapshotModel {
+	return databaseSnapshotModel{
+		Id:            types.StringValue(snapshot.Id),
+		Description:   types.StringValue(snapshot.Description),
+		Status:        types.StringValue(snapshot.Status),
+		RetentionDays: types.Int64Value(snapshot.RetentionDays),
+		CreatedAt:     types.StringValue(snapshot.CreatedAt),
+		ExpiresAt:     types.StringValue(snapshot.ExpiresAt),
+	}
+}
+
+// sortSnapshots orders snapshots by creation time, oldest first, and ties by
+// ID so the order is stable across reads. If any timestamp does not parse,
+// all snapshots are ordered by their raw created_at instead, so the order is
+// still consistent.
+func sortSnapshots(snapshots []DatabaseSnapshot) {
+	byTime := true
+	for _, snapshot := range snapshots {
+		if _, err := time.Parse(time.RFC3339, snapshot.CreatedAt); err != nil {
+			byTime = false
+			break
+		}
+	}
+
+	sort.SliceStable(snapshots, func(i, j int) bool {
+		if byTime {
+			ti, _ := time.Parse(time.RFC3339, snapshots[i].CreatedAt)
+			tj, _ := time.Parse(time.RFC3339, snapshots[j].CreatedAt)
+			if !ti.Equal(tj) {
+				return ti.Before(tj)
+			}
+		} else if snapshots[i].CreatedAt != snapshots[j].CreatedAt {
+			return snapshots[i].CreatedAt < snapshots[j].CreatedAt
+		}
+
+		return snapshots[i].Id < snapshots[j].Id
+	})
+}
+
+// mostRecentSnapshot returns the last available snapshot of snapshots,
+// which must be sorted, or nil if none is available. Like waitForAvailable,
+// it treats a snapshot without a status as available.
+func mostRecentSnapshot(snapshots []DatabaseSnapshot) *DatabaseSnapshot {
+	for i := len(snapshots) - 1; i >= 0; i-- {
+		if isAvailable(snapshots[i].Status) {
+			return &snapshots[i]
+		}
+	}
+
+	return nil
+}
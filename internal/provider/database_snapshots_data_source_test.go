@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccDatabaseSnapshotsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + `
+resource "bobsdiscountcloudco_database" "test" {
+  name                = "tf-acc-snapshots"
+  deletion_protection = false
+}
+
+resource "bobsdiscountcloudco_database_snapshot" "first" {
+  database_id = bobsdiscountcloudco_database.test.id
+}
+
+resource "bobsdiscountcloudco_database_snapshot" "second" {
+  database_id = bobsdiscountcloudco_database.test.id
+
+  depends_on = [bobsdiscountcloudco_database_snapshot.first]
+}
+
+data "bobsdiscountcloudco_database_snapshots" "test" {
+  database_id = bobsdiscountcloudco_database.test.id
+
+  depends_on = [bobsdiscountcloudco_database_snapshot.second]
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.bobsdiscountcloudco_database_snapshots.test",
+						tfjsonpath.New("snapshots"),
+						knownvalue.ListSizeExact(2),
+					),
+					statecheck.CompareValuePairs(
+						"data.bobsdiscountcloudco_database_snapshots.test",
+						tfjsonpath.New("most_recent").AtMapKey("id"),
+						"bobsdiscountcloudco_database_snapshot.second",
+						tfjsonpath.New("id"),
+						compare.ValuesSame(),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestSortSnapshots(t *testing.T) {
+	cases := map[string]struct {
+		snapshots []DatabaseSnapshot
+		want      []string
+	}{
+		// snap-a and snap-c were taken at the same instant, so ID breaks
+		// the tie.
+		"by time": {
+			snapshots: []DatabaseSnapshot{
+				{Id: "snap-c", CreatedAt: "2025-06-01T12:00:00Z"},
+				{Id: "snap-a", CreatedAt: "2025-06-01T14:00:00+02:00"},
+				{Id: "snap-b", CreatedAt: "2025-05-01T00:00:00Z"},
+			},
+			want: []string{"snap-b", "snap-a", "snap-c"},
+		},
+		// An unparsable timestamp orders every snapshot by its raw
+		// created_at, then by ID.
+		"unparsable timestamp": {
+			snapshots: []DatabaseSnapshot{
+				{Id: "snap-d", CreatedAt: "yesterday"},
+				{Id: "snap-c", CreatedAt: "2025-06-01T12:00:00Z"},
+				{Id: "snap-a", CreatedAt: ""},
+				{Id: "snap-b", CreatedAt: "2025-05-01T00:00:00Z"},
+				{Id: "snap-e", CreatedAt: ""},
+			},
+			want: []string{"snap-a", "snap-e", "snap-b", "snap-c", "snap-d"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			sortSnapshots(tc.snapshots)
+
+			for i, snapshot := range tc.snapshots {
+				if snapshot.Id != tc.want[i] {
+					t.Errorf("position %d: got %s, want %s", i, snapshot.Id, tc.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestMostRecentSnapshot(t *testing.T) {
+	cases := map[string]struct {
+		snapshots []DatabaseSnapshot
+		expected  string
+	}{
+		"latest available": {
+			snapshots: []DatabaseSnapshot{
+				{Id: "snap-1", Status: "available"},
+				{Id: "snap-2", Status: "available"},
+			},
+			expected: "snap-2",
+		},
+		"skips unavailable": {
+			snapshots: []DatabaseSnapshot{
+				{Id: "snap-1", Status: "available"},
+				{Id: "snap-2", Status: "creating"},
+				{Id: "snap-3", Status: "failed"},
+			},
+			expected: "snap-1",
+		},
+		"no status": {
+			snapshots: []DatabaseSnapshot{
+				{Id: "snap-1", Status: "available"},
+				{Id: "snap-2"},
+			},
+			expected: "snap-2",
+		},
+		"none available": {
+			snapshots: []DatabaseSnapshot{
+				{Id: "snap-1", Status: "creating"},
+			},
+		},
+		"empty": {},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := mostRecentSnapshot(tc.snapshots)
+
+			if tc.expected == "" {
+				if got != nil {
+					t.Fatalf("got %s, want none", got.Id)
+				}
+				return
+			}
+
+			if got == nil || got.Id != tc.expected {
+				t.Fatalf("got %v, want %s", got, tc.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// defaultDatabaseTier is used when a configuration does not set a tier.
+const defaultDatabaseTier = "standard"
+
+// engineVersionPattern matches engine versions such as 2.1.
+var engineVersionPattern = regexp.MustCompile(`^[0-9]+\.[0-9]+$`)
+
+// databaseTierLimits are the default capacity and the capacity limits of a
+// database tier. New databases default to MaxItems items.
+type databaseTierLimits struct {
+	DefaultStorageGB int64
+	MaxStorageGB     int64
+	MaxItems         int64
+}
+
+// databaseTiers lists the tiers Bob's sells, keyed by tier name.
+var databaseTiers = map[string]databaseTierLimits{
+	"free":     {DefaultStorageGB: 1, MaxStorageGB: 1, MaxItems: 1000},
+	"standard": {DefaultStorageGB: 10, MaxStorageGB: 100, MaxItems: 1000000},
+	"premium":  {DefaultStorageGB: 100, MaxStorageGB: 1000, MaxItems: 100000000},
+}
+
+// databaseTierNames returns the tier names in ascending order of capacity.
+func databaseTierNames() []string {
+	return []string{"free", "standard", "premium"}
+}
+
+// validateDatabaseCapacity checks that storageGB and maxItems fit within
+// the limits of tier. Zero values are treated as unset.
+func validateDatabaseCapacity(tier string, storageGB, maxItems int64) error {
+	limits, ok := databaseTiers[tier]
+	if !ok {
+		return fmt.Errorf("unknown tier %q", tier)
+	}
+
+	if storageGB > limits.MaxStorageGB {
+		return fmt.Errorf("the %s tier allows at most %d GB of storage, got %d", tier, limits.MaxStorageGB, storageGB)
+	}
+
+	if maxItems > limits.MaxItems {
+		return fmt.Errorf("the %s tier allows at most %d items, got %d", tier, limits.MaxItems, maxItems)
+	}
+
+	return nil
+}
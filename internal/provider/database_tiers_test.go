@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import "testing"
+
+func TestValidateDatabaseCapacity(t *testing.T) {
+	cases := map[string]struct {
+		tier                string
+		storageGB, maxItems int64
+		wantErr             bool
+	}{
+		"unset":                  {tier: "free"},
+		"free within limits":     {tier: "free", storageGB: 1, maxItems: 1000},
+		"free too much storage":  {tier: "free", storageGB: 2, wantErr: true},
+		"free too many items":    {tier: "free", maxItems: 1001, wantErr: true},
+		"standard within limits": {tier: "standard", storageGB: 100, maxItems: 1000000},
+		"standard too large":     {tier: "standard", storageGB: 500, wantErr: true},
+		"premium large":          {tier: "premium", storageGB: 1000, maxItems: 100000000},
+		"unknown tier":           {tier: "platinum", wantErr: true},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := validateDatabaseCapacity(tc.tier, tc.storageGB, tc.maxItems)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error: %t", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestDatabaseTierNames(t *testing.T) {
+	names := databaseTierNames()
+	if len(names) != len(databaseTiers) {
+		t.Fatalf("got %d tier names, want %d", len(names), len(databaseTiers))
+	}
+
+	for _, name := range names {
+		if _, ok := databaseTiers[name]; !ok {
+			t.Errorf("tier name %q has no limits", name)
+		}
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Statuses reported by the API for databases and snapshots. Both are
+// usable once they reach statusAvailable.
+const (
+	statusAvailable = "available"
+	statusFailed    = "failed"
+)
+
+// databaseWaitTimeout bounds how long to wait for a database or snapshot to
+// become available, e.g. while a clone copies its source.
+const databaseWaitTimeout = 60 * time.Minute
+
+// databasePollInterval is how often the status is polled. It is a variable
+// so tests can shorten it.
+var databasePollInterval = 10 * time.Second
+
+// waitForDatabaseAvailable polls a database until it is available and
+// returns its final state.
+func waitForDatabaseAvailable(ctx context.Context, client *Client, database_id string) (*CreateDatabaseResponse, error) {
+	var database *CreateDatabaseResponse
+
+	err := waitForAvailable(ctx, "database "+database_id, func() (string, error) {
+		var err error
+		database, err = client.GetDatabase(database_id)
+		if err != nil {
+			return "", err
+		}
+
+		return database.Status, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return database, nil
+}
+
+// waitForSnapshotAvailable polls a snapshot until it is available and
+// returns its final state.
+func waitForSnapshotAvailable(ctx context.Context, client *Client, database_id, snapshot_id string) (*DatabaseSnapshot, error) {
+	var snapshot *DatabaseSnapshot
+
+	err := waitForAvailable(ctx, "snapshot "+snapshot_id, func() (string, error) {
+		var err error
+		snapshot, err = client.GetDatabaseSnapshot(database_id, snapshot_id)
+		if err != nil {
+			return "", err
+		}
+
+		return snapshot.Status, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return snapshot, nil
+}
+
+// isAvailable reports whether status is statusAvailable. An empty status is
+// treated as available, for services that do not report one.
+func isAvailable(status string) bool {
+	return status == "" || status == statusAvailable
+}
+
+// waitForAvailable calls status until isAvailable reports it available.
+func waitForAvailable(ctx context.Context, name string, status func() (string, error)) error {
+	ctx, cancel := context.WithTimeout(ctx, databaseWaitTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(databasePollInterval)
+	defer ticker.Stop()
+
+	for {
+		current, err := status()
+		if err != nil {
+			return err
+		}
+
+		if isAvailable(current) {
+			return nil
+		}
+		if current == statusFailed {
+			return fmt.Errorf("%s failed to become available", name)
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%s is still %s: %w", name, current, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
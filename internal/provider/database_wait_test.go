@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWaitForDatabaseAvailable(t *testing.T) {
+	defaultInterval := databasePollInterval
+	databasePollInterval = time.Millisecond
+	t.Cleanup(func() { databasePollInterval = defaultInterval })
+
+	cases := map[string]struct {
+		statuses    []string
+		expectError string
+		expectPolls int
+	}{
+		"available": {
+			statuses:    []string{"creating", "creating", "available"},
+			expectPolls: 3,
+		},
+		"no status": {
+			statuses:    []string{""},
+			expectPolls: 1,
+		},
+		"failed": {
+			statuses:    []string{"creating", "failed"},
+			expectError: "failed to become available",
+			expectPolls: 2,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var polls int
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				status := tc.statuses[min(polls, len(tc.statuses)-1)]
+				polls++
+				_ = json.NewEncoder(w).Encode(CreateDatabaseResponse{Id: "db-1", Name: "orders", Status: status})
+			}))
+			t.Cleanup(server.Close)
+
+			client := &Client{HostURL: server.URL, HTTPClient: server.Client()}
+
+			database, err := waitForDatabaseAvailable(context.Background(), client, "db-1")
+
+			if tc.expectError != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.expectError) {
+					t.Fatalf("got error %v, want %q", err, tc.expectError)
+				}
+			} else if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			} else if database.Id != "db-1" {
+				t.Errorf("got database %q, want db-1", database.Id)
+			}
+
+			if polls != tc.expectPolls {
+				t.Errorf("got %d polls, want %d", polls, tc.expectPolls)
+			}
+		})
+	}
+}
+
+func TestWaitForDatabaseAvailable_Cancelled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(CreateDatabaseResponse{Id: "db-1", Status: "creating"})
+	}))
+	t.Cleanup(server.Close)
+
+	client := &Client{HostURL: server.URL, HTTPClient: server.Client()}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := waitForDatabaseAvailable(ctx, client, "db-1")
+	if err == nil || !strings.Contains(err.Error(), "is still creating") {
+		t.Fatalf("got error %v, want a still creating error", err)
+	}
+}
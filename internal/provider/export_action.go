@@ -0,0 +1,238 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gopkg.in/yaml.v3"
+)
+
+// defaultExportFormat is used when an export does not set a format.
+const defaultExportFormat = "json"
+
+// exportFormats lists the file formats items can be exported in.
+func exportFormats() []string {
+	return []string{"json", "ndjson", "csv", "yaml"}
+}
+
+func NewExportAction() action.Action {
+	return &exportAction{}
+}
+
+type exportAction struct {
+	client *Client
+}
+
+var (
+	_ action.Action              = (*exportAction)(nil)
+	_ action.ActionWithConfigure = (*exportAction)(nil)
+)
+
+// Configure adds the provider configured client to the action.
+func (a *exportAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *provider.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.client = client
+}
+
+type exportActionModel struct {
+	DatabaseId types.String `tfsdk:"database_id"`
+	Path       types.String `tfsdk:"path"`
+	Format     types.String `tfsdk:"format"`
+	KeyPrefix  types.String `tfsdk:"key_prefix"`
+}
+
+func (a *exportAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_export_action"
+}
+
+func (a *exportAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Exports the items of a database to a local file and reports the SHA-256 checksum of the file.",
+		Attributes: map[string]schema.Attribute{
+			"database_id": schema.StringAttribute{
+				Description: "ID of the database to export.",
+				Required:    true,
+			},
+			"path": schema.StringAttribute{
+				Description: "Local path to write the export to. An existing file is replaced once the export has completed.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"format": schema.StringAttribute{
+				Description: "File format. One of `json`, `ndjson`, `csv` or `yaml`. Defaults to `json`.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(exportFormats()...),
+				},
+			},
+			"key_prefix": schema.StringAttribute{
+				Description: "Only export items whose key starts with this prefix.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (a *exportAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config exportActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	database_id := config.DatabaseId.ValueString()
+	format := defaultExportFormat
+	if !config.Format.IsNull() {
+		format = config.Format.ValueString()
+	}
+
+	var items []DatabaseItem
+	listRequest := ListDatabaseItemsRequest{
+		Prefix: config.KeyPrefix.ValueString(),
+	}
+
+	for {
+		page, err := a.client.ListDatabaseItems(listRequest, database_id)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Listing Database Items",
+				"Could not list items of database ID "+database_id+": "+err.Error(),
+			)
+			return
+		}
+
+		items = append(items, page.Items...)
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Read %d items from database %s", len(items), database_id),
+		})
+
+		if page.NextToken == "" {
+			break
+		}
+		listRequest.NextToken = page.NextToken
+	}
+
+	checksum, err := writeExportFile(config.Path.ValueString(), format, items)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Writing Export",
+			"Could not write the export of database ID "+database_id+" to "+config.Path.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Exported %d items to %s (sha256:%s)", len(items), config.Path.ValueString(), checksum),
+	})
+}
+
+// writeExportFile writes items to path in format and returns the hex
+// SHA-256 checksum of the file. The file is written next to path and renamed
+// into place, so a failed export never leaves a partial file behind.
+func writeExportFile(path, format string, items []DatabaseItem) (string, error) {
+	file, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*")
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(file.Name())
+
+	hash := sha256.New()
+	if err := encodeItems(io.MultiWriter(file, hash), format, items); err != nil {
+		file.Close()
+		return "", err
+	}
+
+	if err := file.Close(); err != nil {
+		return "", err
+	}
+
+	if err := os.Rename(file.Name(), path); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// exportItem is the representation of an item in json, ndjson and yaml
+// exports.
+type exportItem struct {
+	Key   string `json:"key" yaml:"key"`
+	Value string `json:"value" yaml:"value"`
+}
+
+// encodeItems writes items to w in format.
+func encodeItems(w io.Writer, format string, items []DatabaseItem) error {
+	records := make([]exportItem, 0, len(items))
+	for _, item := range items {
+		records = append(records, exportItem{Key: item.Key, Value: item.Value})
+	}
+
+	switch format {
+	case "json":
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(records)
+	case "ndjson":
+		encoder := json.NewEncoder(w)
+		for _, record := range records {
+			if err := encoder.Encode(record); err != nil {
+				return err
+			}
+		}
+		return nil
+	case "csv":
+		writer := csv.NewWriter(w)
+		if err := writer.Write([]string{"key", "value"}); err != nil {
+			return err
+		}
+		for _, record := range records {
+			if err := writer.Write([]string{record.Key, record.Value}); err != nil {
+				return err
+			}
+		}
+		writer.Flush()
+		return writer.Error()
+	case "yaml":
+		encoder := yaml.NewEncoder(w)
+		encoder.SetIndent(2)
+		if err := encoder.Encode(records); err != nil {
+			return err
+		}
+		return encoder.Close()
+	default:
+		return fmt.Errorf("unsupported export format %q", format)
+	}
+}
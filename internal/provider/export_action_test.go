@@ -0,0 +1,170 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestEncodeItems(t *testing.T) {
+	items := []DatabaseItem{
+		{Key: "config/flags", Value: `{"beta":true}`},
+		{Key: "config/motd", Value: "hello, world"},
+	}
+
+	cases := map[string]string{
+		"json": `[
+  {
+    "key": "config/flags",
+    "value": "{\"beta\":true}"
+  },
+  {
+    "key": "config/motd",
+    "value": "hello, world"
+  }
+]
+`,
+		"ndjson": `{"key":"config/flags","value":"{\"beta\":true}"}
+{"key":"config/motd","value":"hello, world"}
+`,
+		"csv": `key,value
+config/flags,"{""beta"":true}"
+config/motd,"hello, world"
+`,
+		"yaml": `- key: config/flags
+  value: '{"beta":true}'
+- key: config/motd
+  value: hello, world
+`,
+	}
+
+	for format, expected := range cases {
+		t.Run(format, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := encodeItems(&buf, format, items); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got := buf.String(); got != expected {
+				t.Errorf("got:\n%s\nwant:\n%s", got, expected)
+			}
+		})
+	}
+
+	// Every schema format must be encodable.
+	for _, format := range exportFormats() {
+		if _, ok := cases[format]; !ok {
+			t.Errorf("format %s is not covered", format)
+		}
+	}
+}
+
+func TestEncodeItems_Empty(t *testing.T) {
+	var buf bytes.Buffer
+	if err := encodeItems(&buf, "json", nil); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got := buf.String(); got != "[]\n" {
+		t.Errorf("got %q, want an empty JSON array", got)
+	}
+}
+
+func TestExportAction_Invoke(t *testing.T) {
+	pages := map[string]ListDatabaseItemsResponse{
+		"": {
+			Items:     []DatabaseItem{{Key: "orders/1", Value: "one"}},
+			NextToken: "page-2",
+		},
+		"page-2": {
+			Items: []DatabaseItem{{Key: "orders/2", Value: "two"}},
+		},
+	}
+
+	var prefixes []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/database/db-1/items" {
+			http.NotFound(w, r)
+			return
+		}
+
+		prefixes = append(prefixes, r.URL.Query().Get("prefix"))
+		_ = json.NewEncoder(w).Encode(pages[r.URL.Query().Get("next_token")])
+	}))
+	t.Cleanup(server.Close)
+
+	a := &exportAction{client: &Client{HostURL: server.URL, HTTPClient: server.Client()}}
+	ctx := context.Background()
+
+	schemaResp := &action.SchemaResponse{}
+	a.Schema(ctx, action.SchemaRequest{}, schemaResp)
+
+	path := filepath.Join(t.TempDir(), "export.ndjson")
+
+	var progress []string
+	resp := &action.InvokeResponse{
+		SendProgress: func(event action.InvokeProgressEvent) {
+			progress = append(progress, event.Message)
+		},
+	}
+
+	a.Invoke(ctx, action.InvokeRequest{
+		Config: tfsdk.Config{
+			Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{
+				"database_id": tftypes.NewValue(tftypes.String, "db-1"),
+				"path":        tftypes.NewValue(tftypes.String, path),
+				"format":      tftypes.NewValue(tftypes.String, "ndjson"),
+				"key_prefix":  tftypes.NewValue(tftypes.String, "orders/"),
+			}),
+			Schema: schemaResp.Schema,
+		},
+	}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	if strings.Join(prefixes, ",") != "orders/,orders/" {
+		t.Errorf("got prefixes %q, want orders/ on every page", prefixes)
+	}
+
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "{\"key\":\"orders/1\",\"value\":\"one\"}\n{\"key\":\"orders/2\",\"value\":\"two\"}\n"
+	if string(contents) != expected {
+		t.Errorf("got file:\n%s\nwant:\n%s", contents, expected)
+	}
+
+	sum := sha256.Sum256(contents)
+	checksum := "sha256:" + hex.EncodeToString(sum[:])
+	if last := progress[len(progress)-1]; !strings.Contains(last, checksum) {
+		t.Errorf("got final progress %q, want it to report %s", last, checksum)
+	}
+
+	// Only the export itself is left in the directory.
+	entries, err := os.ReadDir(filepath.Dir(path))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 {
+		t.Errorf("got %d files in the export directory, want 1", len(entries))
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+const (
+	// databaseIDPrefix is the leading component of a fully qualified
+	// database ID, e.g. bdcc:us-east-1:123456789012:db-0123abcd.
+	databaseIDPrefix = "bdcc"
+
+	// itemKeySeparator joins the parts of a composite item key.
+	itemKeySeparator = "/"
+
+	// itemKeyMaxLength is the longest item key the API accepts, in bytes.
+	itemKeyMaxLength = 256
+
+	// itemValueMaxLength is the largest item value the API accepts, in bytes.
+	itemValueMaxLength = 64 * 1024
+
+	// databaseNameMinLength and databaseNameMaxLength bound the length of
+	// database names.
+	databaseNameMinLength = 3
+	databaseNameMaxLength = 63
+)
+
+// reservedDatabaseNames cannot be used as database names.
+var reservedDatabaseNames = []string{"admin", "bobs", "default", "internal", "system"}
+
+var (
+	regionPattern      = regexp.MustCompile(`^[a-z]{2}-[a-z]+-[0-9]+$`)
+	accountIDPattern   = regexp.MustCompile(`^[0-9]{12}$`)
+	databaseIDPattern  = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+	itemKeyPartPattern = regexp.MustCompile(`^[A-Za-z0-9_.-]+$`)
+
+	// endpointHostPattern matches the host of a regional API endpoint.
+	endpointHostPattern = regexp.MustCompile(`^api\.([a-z]{2}-[a-z]+-[0-9]+)\.whybobs\.com$`)
+
+	// itemKeyPattern matches keys made of valid parts joined by itemKeySeparator.
+	itemKeyPattern = regexp.MustCompile(`^[A-Za-z0-9_.-]+(/[A-Za-z0-9_.-]+)*$`)
+
+	// databaseNamePattern requires lowercase letters, digits and hyphens,
+	// starting with a letter and not ending with a hyphen.
+	databaseNamePattern = regexp.MustCompile(`^[a-z][a-z0-9-]*[a-z0-9]$`)
+)
+
+// databaseIDParts are the components of a database ID. Region and AccountID
+// are empty for the plain IDs returned by the API.
+type databaseIDParts struct {
+	Region     string
+	AccountID  string
+	DatabaseID string
+}
+
+// parseDatabaseID splits a database ID into its components. It accepts both
+// the plain IDs returned by the API, such as db-0123abcd, and fully qualified
+// IDs of the form bdcc:<region>:<account_id>:<database_id>.
+func parseDatabaseID(id string) (*databaseIDParts, error) {
+	if !strings.Contains(id, ":") {
+		if !databaseIDPattern.MatchString(id) {
+			return nil, fmt.Errorf("%q is not a database ID, expected a plain ID such as db-0123abcd or the form %s:<region>:<account_id>:<database_id>", id, databaseIDPrefix)
+		}
+
+		return &databaseIDParts{DatabaseID: id}, nil
+	}
+
+	parts := strings.Split(id, ":")
+	if len(parts) != 4 || parts[0] != databaseIDPrefix {
+		return nil, fmt.Errorf("%q is not a database ID, expected a plain ID such as db-0123abcd or the form %s:<region>:<account_id>:<database_id>", id, databaseIDPrefix)
+	}
+
+	if !regionPattern.MatchString(parts[1]) {
+		return nil, fmt.Errorf("%q has an invalid region %q", id, parts[1])
+	}
+
+	if !accountIDPattern.MatchString(parts[2]) {
+		return nil, fmt.Errorf("%q has an invalid account ID %q, expected 12 digits", id, parts[2])
+	}
+
+	if !databaseIDPattern.MatchString(parts[3]) {
+		return nil, fmt.Errorf("%q has an invalid database ID %q", id, parts[3])
+	}
+
+	return &databaseIDParts{
+		Region:     parts[1],
+		AccountID:  parts[2],
+		DatabaseID: parts[3],
+	}, nil
+}
+
+// buildEndpoint returns the API endpoint serving the given region.
+func buildEndpoint(region string) (string, error) {
+	if !regionPattern.MatchString(region) {
+		return "", fmt.Errorf("%q is not a valid region, expected a value such as us-east-1", region)
+	}
+
+	return fmt.Sprintf("https://api.%s.whybobs.com", region), nil
+}
+
+// regionFromHost returns the region served by an API endpoint URL, or an
+// empty string when the endpoint is not a regional Bob's endpoint (for
+// example a local test server).
+func regionFromHost(hostURL string) string {
+	u, err := url.Parse(hostURL)
+	if err != nil {
+		return ""
+	}
+
+	match := endpointHostPattern.FindStringSubmatch(u.Hostname())
+	if match == nil {
+		return ""
+	}
+
+	return match[1]
+}
+
+// buildItemKey joins parts into a composite item key. Every part must be
+// non-empty and limited to letters, digits, '_', '.' and '-', and the
+// resulting key must not exceed itemKeyMaxLength bytes.
+func buildItemKey(parts ...string) (string, error) {
+	if len(parts) == 0 {
+		return "", fmt.Errorf("at least one key part is required")
+	}
+
+	for i, part := range parts {
+		if !itemKeyPartPattern.MatchString(part) {
+			return "", fmt.Errorf("key part %d (%q) must be non-empty and contain only letters, digits, '_', '.' and '-'", i, part)
+		}
+	}
+
+	key := strings.Join(parts, itemKeySeparator)
+	if len(key) > itemKeyMaxLength {
+		return "", fmt.Errorf("key %q is %d bytes long, the maximum is %d", key, len(key), itemKeyMaxLength)
+	}
+
+	return key, nil
+}
+
+// validateItemKey checks that key is a valid item key, as enforced by
+// itemKeyValidators, for keys computed at apply time.
+func validateItemKey(key string) error {
+	if !itemKeyPattern.MatchString(key) {
+		return fmt.Errorf("key %q must be one or more parts of letters, digits, '_', '.' and '-' separated by %q", key, itemKeySeparator)
+	}
+
+	if len(key) > itemKeyMaxLength {
+		return fmt.Errorf("key %q is %d bytes long, the maximum is %d", key, len(key), itemKeyMaxLength)
+	}
+
+	return nil
+}
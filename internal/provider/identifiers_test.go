@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseDatabaseID(t *testing.T) {
+	cases := map[string]struct {
+		id      string
+		want    databaseIDParts
+		wantErr bool
+	}{
+		"valid": {
+			id:   "bdcc:us-east-1:123456789012:db-0123abcd",
+			want: databaseIDParts{Region: "us-east-1", AccountID: "123456789012", DatabaseID: "db-0123abcd"},
+		},
+		"plain": {
+			id:   "db-0123abcd",
+			want: databaseIDParts{DatabaseID: "db-0123abcd"},
+		},
+		"empty":           {id: "", wantErr: true},
+		"invalid plain":   {id: "db 0123abcd", wantErr: true},
+		"short qualified": {id: "bdcc:db-0123abcd", wantErr: true},
+		"wrong prefix":    {id: "arn:us-east-1:123456789012:db-0123abcd", wantErr: true},
+		"bad region":      {id: "bdcc:useast1:123456789012:db-0123abcd", wantErr: true},
+		"bad account":     {id: "bdcc:us-east-1:1234:db-0123abcd", wantErr: true},
+		"empty database":  {id: "bdcc:us-east-1:123456789012:", wantErr: true},
+		"bad database":    {id: "bdcc:us-east-1:123456789012:db/1", wantErr: true},
+		"too many fields": {id: "bdcc:us-east-1:123456789012:db:extra", wantErr: true},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := parseDatabaseID(tc.id)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got %+v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if *got != tc.want {
+				t.Errorf("got %+v, want %+v", *got, tc.want)
+			}
+		})
+	}
+}
+
+func TestBuildItemKey(t *testing.T) {
+	cases := map[string]struct {
+		parts   []string
+		want    string
+		wantErr bool
+	}{
+		"single":      {parts: []string{"flags"}, want: "flags"},
+		"composite":   {parts: []string{"config", "feature-flags", "v1.2"}, want: "config/feature-flags/v1.2"},
+		"no parts":    {parts: nil, wantErr: true},
+		"empty part":  {parts: []string{"config", ""}, wantErr: true},
+		"slash":       {parts: []string{"config/flags"}, wantErr: true},
+		"whitespace":  {parts: []string{"my key"}, wantErr: true},
+		"at limit":    {parts: []string{strings.Repeat("a", itemKeyMaxLength)}, want: strings.Repeat("a", itemKeyMaxLength)},
+		"over limit":  {parts: []string{strings.Repeat("a", itemKeyMaxLength), "b"}, wantErr: true},
+		"underscores": {parts: []string{"_private", "x_y"}, want: "_private/x_y"},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := buildItemKey(tc.parts...)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got %q", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if got != tc.want {
+				t.Errorf("got %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestRegionFromHost(t *testing.T) {
+	cases := map[string]string{
+		"https://api.us-east-1.whybobs.com":      "us-east-1",
+		"https://api.eu-west-2.whybobs.com:443/": "eu-west-2",
+		"http://127.0.0.1:8080":                  "",
+		"https://api.whybobs.com":                "",
+		"not a url %":                            "",
+	}
+
+	for host, want := range cases {
+		if got := regionFromHost(host); got != want {
+			t.Errorf("regionFromHost(%q) = %q, want %q", host, got, want)
+		}
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+// Kinds of change writing an item makes to a database.
+const (
+	itemChangeCreate    = "create"
+	itemChangeUpdate    = "update"
+	itemChangeUnchanged = "unchanged"
+)
+
+// itemChange is the change writing an item makes to a database.
+type itemChange struct {
+	Key   string
+	Value string
+	Kind  string
+}
+
+// diffItems classifies writing items into a database that currently holds
+// existing, keyed by item key. Changes are returned in the order of items.
+func diffItems(existing map[string]string, items []DatabaseItem) []itemChange {
+	changes := make([]itemChange, 0, len(items))
+
+	for _, item := range items {
+		change := itemChange{Key: item.Key, Value: item.Value, Kind: itemChangeCreate}
+
+		if value, ok := existing[item.Key]; ok {
+			change.Kind = itemChangeUpdate
+			if value == item.Value {
+				change.Kind = itemChangeUnchanged
+			}
+		}
+
+		changes = append(changes, change)
+	}
+
+	return changes
+}
+
+// existingItemValues returns the values of the items of a database whose key
+// starts with prefix, keyed by item key.
+func existingItemValues(client *Client, database_id, prefix string) (map[string]string, error) {
+	items, err := client.ListAllDatabaseItems(prefix, database_id)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]string, len(items))
+	for _, item := range items {
+		values[item.Key] = item.Value
+	}
+
+	return values, nil
+}
+
+// countItemChanges returns the number of changes of each kind.
+func countItemChanges(changes []itemChange) map[string]int {
+	counts := map[string]int{}
+	for _, change := range changes {
+		counts[change.Kind]++
+	}
+
+	return counts
+}
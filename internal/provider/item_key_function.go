@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var (
+	_ function.Function = ItemKeyFunction{}
+)
+
+func NewItemKeyFunction() function.Function {
+	return ItemKeyFunction{}
+}
+
+type ItemKeyFunction struct{}
+
+func (r ItemKeyFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "item_key"
+}
+
+func (r ItemKeyFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Compose an item key",
+		MarkdownDescription: "Joins the given parts with `/` into a database item key. Each part must be non-empty and contain only letters, digits, `_`, `.` and `-`, and the resulting key must be at most 256 bytes long.",
+		VariadicParameter: function.StringParameter{
+			Name:                "parts",
+			MarkdownDescription: "Key parts to join, in order",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (r ItemKeyFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var parts []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &parts))
+
+	if resp.Error != nil {
+		return
+	}
+
+	key, err := buildItemKey(parts...)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, key))
+}
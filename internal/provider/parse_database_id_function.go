@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ function.Function = ParseDatabaseIDFunction{}
+)
+
+// databaseIDAttributeTypes describes the object returned by parse_database_id.
+var databaseIDAttributeTypes = map[string]attr.Type{
+	"region":      types.StringType,
+	"account_id":  types.StringType,
+	"database_id": types.StringType,
+}
+
+func NewParseDatabaseIDFunction() function.Function {
+	return ParseDatabaseIDFunction{}
+}
+
+type ParseDatabaseIDFunction struct{}
+
+func (r ParseDatabaseIDFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_database_id"
+}
+
+func (r ParseDatabaseIDFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Parse a database ID",
+		MarkdownDescription: "Splits a database ID into an object with `region`, `account_id` and `database_id` attributes. Accepts the plain IDs returned by the provider, such as `db-0123abcd`, for which `region` and `account_id` are null, and fully qualified IDs of the form `bdcc:<region>:<account_id>:<database_id>`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "id",
+				MarkdownDescription: "Plain or fully qualified database ID to parse",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: databaseIDAttributeTypes,
+		},
+	}
+}
+
+func (r ParseDatabaseIDFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var id string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &id))
+
+	if resp.Error != nil {
+		return
+	}
+
+	parts, err := parseDatabaseID(id)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	result, diags := types.ObjectValue(databaseIDAttributeTypes, map[string]attr.Value{
+		"region":      optionalString(parts.Region),
+		"account_id":  optionalString(parts.AccountID),
+		"database_id": types.StringValue(parts.DatabaseID),
+	})
+	resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, diags))
+
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// optionalString returns null for an empty string.
+func optionalString(s string) types.String {
+	if s == "" {
+		return types.StringNull()
+	}
+
+	return types.StringValue(s)
+}
@@ -13,7 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
-func TestExampleFunction_Known(t *testing.T) {
+func TestParseDatabaseIDFunction_Known(t *testing.T) {
 	resource.UnitTest(t, resource.TestCase{
 		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
 			tfversion.SkipBelow(tfversion.Version1_8_0),
@@ -23,13 +23,17 @@ func TestExampleFunction_Known(t *testing.T) {
 			{
 				Config: `
 				output "test" {
-					value = provider::scaffolding::example("testvalue")
+					value = provider::bobsdiscountcloudco::parse_database_id("bdcc:us-east-1:123456789012:db-0123abcd")
 				}
 				`,
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownOutputValue(
 						"test",
-						knownvalue.StringExact("testvalue"),
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"region":      knownvalue.StringExact("us-east-1"),
+							"account_id":  knownvalue.StringExact("123456789012"),
+							"database_id": knownvalue.StringExact("db-0123abcd"),
+						}),
 					),
 				},
 			},
@@ -37,7 +41,7 @@ func TestExampleFunction_Known(t *testing.T) {
 	})
 }
 
-func TestExampleFunction_Null(t *testing.T) {
+func TestParseDatabaseIDFunction_Plain(t *testing.T) {
 	resource.UnitTest(t, resource.TestCase{
 		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
 			tfversion.SkipBelow(tfversion.Version1_8_0),
@@ -47,17 +51,25 @@ func TestExampleFunction_Null(t *testing.T) {
 			{
 				Config: `
 				output "test" {
-					value = provider::scaffolding::example(null)
+					value = provider::bobsdiscountcloudco::parse_database_id("db-0123abcd")
 				}
 				`,
-				// The parameter does not enable AllowNullValue
-				ExpectError: regexp.MustCompile(`argument must not be null`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue(
+						"test",
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"region":      knownvalue.Null(),
+							"account_id":  knownvalue.Null(),
+							"database_id": knownvalue.StringExact("db-0123abcd"),
+						}),
+					),
+				},
 			},
 		},
 	})
 }
 
-func TestExampleFunction_Unknown(t *testing.T) {
+func TestParseDatabaseIDFunction_Invalid(t *testing.T) {
 	resource.UnitTest(t, resource.TestCase{
 		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
 			tfversion.SkipBelow(tfversion.Version1_8_0),
@@ -66,20 +78,11 @@ func TestExampleFunction_Unknown(t *testing.T) {
 		Steps: []resource.TestStep{
 			{
 				Config: `
-				resource "terraform_data" "test" {
-					input = "testvalue"
-				}
-				
 				output "test" {
-					value = provider::scaffolding::example(terraform_data.test.output)
+					value = provider::bobsdiscountcloudco::parse_database_id("bdcc:db-0123abcd")
 				}
 				`,
-				ConfigStateChecks: []statecheck.StateCheck{
-					statecheck.ExpectKnownOutputValue(
-						"test",
-						knownvalue.StringExact("testvalue"),
-					),
-				},
+				ExpectError: regexp.MustCompile(`is not a database ID`),
 			},
 		},
 	})
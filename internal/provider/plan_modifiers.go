@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ planmodifier.Bool = defaultOnCreateBoolModifier{}
+
+// defaultOnCreateBoolModifier sets an unconfigured attribute to a default
+// value on create, and otherwise keeps the value in state.
+type defaultOnCreateBoolModifier struct {
+	value bool
+}
+
+// defaultOnCreate returns a plan modifier which plans value for new
+// resources that do not configure the attribute. Unlike a schema Default, it
+// leaves existing resources at their current value, so adding the attribute
+// does not plan a change for resources created before it existed.
+func defaultOnCreate(value bool) planmodifier.Bool {
+	return defaultOnCreateBoolModifier{value: value}
+}
+
+func (m defaultOnCreateBoolModifier) Description(_ context.Context) string {
+	return fmt.Sprintf("defaults to %t on create", m.value)
+}
+
+func (m defaultOnCreateBoolModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m defaultOnCreateBoolModifier) PlanModifyBool(_ context.Context, req planmodifier.BoolRequest, resp *planmodifier.BoolResponse) {
+	if !req.ConfigValue.IsNull() {
+		return
+	}
+
+	if req.State.Raw.IsNull() {
+		resp.PlanValue = types.BoolValue(m.value)
+		return
+	}
+
+	resp.PlanValue = req.StateValue
+}
+
+var _ planmodifier.Int64 = tierCapacityModifier{}
+
+// tierCapacityModifier plans the new tier's default for an unconfigured
+// capacity attribute when the tier of a database changes, and otherwise
+// keeps the value in state.
+type tierCapacityModifier struct {
+	description string
+	capacity    func(databaseTierLimits) int64
+}
+
+// tierDefaultStorage plans the default storage_gb of a changed tier.
+func tierDefaultStorage() planmodifier.Int64 {
+	return tierCapacityModifier{
+		description: "defaults to the storage of the tier",
+		capacity:    func(limits databaseTierLimits) int64 { return limits.DefaultStorageGB },
+	}
+}
+
+// tierDefaultMaxItems plans the item limit of a changed tier.
+func tierDefaultMaxItems() planmodifier.Int64 {
+	return tierCapacityModifier{
+		description: "defaults to the item limit of the tier",
+		capacity:    func(limits databaseTierLimits) int64 { return limits.MaxItems },
+	}
+}
+
+func (m tierCapacityModifier) Description(_ context.Context) string {
+	return m.description
+}
+
+func (m tierCapacityModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m tierCapacityModifier) PlanModifyInt64(ctx context.Context, req planmodifier.Int64Request, resp *planmodifier.Int64Response) {
+	// New databases get their capacity from the API.
+	if !req.ConfigValue.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var planTier, stateTier types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("tier"), &planTier)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("tier"), &stateTier)...)
+	if resp.Diagnostics.HasError() || planTier.IsUnknown() {
+		return
+	}
+
+	if planTier.Equal(stateTier) {
+		resp.PlanValue = req.StateValue
+		return
+	}
+
+	limits, ok := databaseTiers[planTier.ValueString()]
+	if !ok {
+		return
+	}
+
+	resp.PlanValue = types.Int64Value(m.capacity(limits))
+}
@@ -0,0 +1,184 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestDefaultOnCreate(t *testing.T) {
+	stateType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"deletion_protection": tftypes.Bool}}
+	existing := tftypes.NewValue(stateType, map[string]tftypes.Value{
+		"deletion_protection": tftypes.NewValue(tftypes.Bool, nil),
+	})
+
+	cases := map[string]struct {
+		config   types.Bool
+		state    types.Bool
+		create   bool
+		expected types.Bool
+	}{
+		"create": {
+			config:   types.BoolNull(),
+			state:    types.BoolNull(),
+			create:   true,
+			expected: types.BoolValue(true),
+		},
+		"create configured": {
+			config:   types.BoolValue(false),
+			state:    types.BoolNull(),
+			create:   true,
+			expected: types.BoolValue(false),
+		},
+		"existing keeps state": {
+			config:   types.BoolNull(),
+			state:    types.BoolValue(false),
+			expected: types.BoolValue(false),
+		},
+		"existing without a value": {
+			config:   types.BoolNull(),
+			state:    types.BoolNull(),
+			expected: types.BoolNull(),
+		},
+		"existing configured": {
+			config:   types.BoolValue(true),
+			state:    types.BoolValue(false),
+			expected: types.BoolValue(true),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			state := tfsdk.State{Raw: existing}
+			if tc.create {
+				state = tfsdk.State{Raw: tftypes.NewValue(stateType, nil)}
+			}
+
+			// Terraform plans unconfigured computed values as unknown.
+			plan := tc.config
+			if plan.IsNull() {
+				plan = types.BoolUnknown()
+			}
+
+			req := planmodifier.BoolRequest{
+				ConfigValue: tc.config,
+				StateValue:  tc.state,
+				PlanValue:   plan,
+				State:       state,
+			}
+			resp := &planmodifier.BoolResponse{PlanValue: req.PlanValue}
+
+			defaultOnCreate(true).PlanModifyBool(context.Background(), req, resp)
+
+			if !resp.PlanValue.Equal(tc.expected) {
+				t.Errorf("got plan %s, want %s", resp.PlanValue, tc.expected)
+			}
+		})
+	}
+}
+
+func TestTierCapacityModifier(t *testing.T) {
+	ctx := context.Background()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"tier":       schema.StringAttribute{Optional: true, Computed: true},
+			"storage_gb": schema.Int64Attribute{Optional: true, Computed: true},
+		},
+	}
+	objectType := testSchema.Type().TerraformType(ctx)
+
+	object := func(tier, storageGB any) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"tier":       tftypes.NewValue(tftypes.String, tier),
+			"storage_gb": tftypes.NewValue(tftypes.Number, storageGB),
+		})
+	}
+
+	cases := map[string]struct {
+		config   types.Int64
+		state    tftypes.Value
+		plan     tftypes.Value
+		expected types.Int64
+	}{
+		"create": {
+			config:   types.Int64Null(),
+			state:    tftypes.NewValue(objectType, nil),
+			plan:     object("premium", tftypes.UnknownValue),
+			expected: types.Int64Unknown(),
+		},
+		"same tier keeps state": {
+			config:   types.Int64Null(),
+			state:    object("standard", 50),
+			plan:     object("standard", tftypes.UnknownValue),
+			expected: types.Int64Value(50),
+		},
+		"upgrade": {
+			config:   types.Int64Null(),
+			state:    object("standard", 10),
+			plan:     object("premium", tftypes.UnknownValue),
+			expected: types.Int64Value(100),
+		},
+		"downgrade": {
+			config:   types.Int64Null(),
+			state:    object("premium", 100),
+			plan:     object("free", tftypes.UnknownValue),
+			expected: types.Int64Value(1),
+		},
+		"unknown tier": {
+			config:   types.Int64Null(),
+			state:    object("standard", 10),
+			plan:     object(tftypes.UnknownValue, tftypes.UnknownValue),
+			expected: types.Int64Unknown(),
+		},
+		"configured": {
+			config:   types.Int64Value(20),
+			state:    object("standard", 10),
+			plan:     object("premium", 20),
+			expected: types.Int64Value(20),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var stateValue types.Int64
+			if !tc.state.IsNull() {
+				if diags := (tfsdk.State{Raw: tc.state, Schema: testSchema}).GetAttribute(ctx, path.Root("storage_gb"), &stateValue); diags.HasError() {
+					t.Fatalf("unexpected diagnostics: %v", diags)
+				}
+			}
+
+			var planValue types.Int64
+			if diags := (tfsdk.Plan{Raw: tc.plan, Schema: testSchema}).GetAttribute(ctx, path.Root("storage_gb"), &planValue); diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			req := planmodifier.Int64Request{
+				ConfigValue: tc.config,
+				StateValue:  stateValue,
+				PlanValue:   planValue,
+				State:       tfsdk.State{Raw: tc.state, Schema: testSchema},
+				Plan:        tfsdk.Plan{Raw: tc.plan, Schema: testSchema},
+			}
+			resp := &planmodifier.Int64Response{PlanValue: req.PlanValue}
+
+			tierDefaultStorage().PlanModifyInt64(ctx, req, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			if !resp.PlanValue.Equal(tc.expected) {
+				t.Errorf("got plan %s, want %s", resp.PlanValue, tc.expected)
+			}
+		})
+	}
+}
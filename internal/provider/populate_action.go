@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -52,6 +53,7 @@ func (a *populateAction) Configure(_ context.Context, req action.ConfigureReques
 type populateActionModel struct {
 	DatabaseId types.String        `tfsdk:"id"`
 	Items      []databaseItemModel `tfsdk:"items"`
+	DryRun     types.Bool          `tfsdk:"dry_run"`
 }
 
 // orderItemModel maps order item data.
@@ -75,17 +77,26 @@ func (a *populateAction) Schema(ctx context.Context, req action.SchemaRequest, r
 			"items": schema.ListNestedAttribute{
 				Description: "The JSON payload to send to the Lambda function. This should be a valid JSON string that represents the event data for your function.",
 				Required:    true,
+				Validators: []validator.List{
+					uniqueItemKeys(),
+				},
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"key": schema.StringAttribute{
-							Required: true,
+							Required:   true,
+							Validators: itemKeyValidators(),
 						},
 						"value": schema.StringAttribute{
-							Required: true,
+							Required:   true,
+							Validators: itemValueValidators(),
 						},
 					},
 				},
 			},
+			"dry_run": schema.BoolAttribute{
+				Description: "Only report which items would be created, updated or left unchanged, without writing to the database.",
+				Optional:    true,
+			},
 		},
 	}
 }
@@ -104,6 +115,10 @@ func (a *populateAction) Invoke(ctx context.Context, req action.InvokeRequest, r
 	tflog.Info(ctx, "Invoking Populator", map[string]any{
 		"database_id": string(config.DatabaseId.ValueString()),
 	})
+	if config.DryRun.ValueBool() {
+		a.dryRun(config, resp)
+		return
+	}
 	time.Sleep(10 * time.Second)
 	for _, item := range config.Items {
 		itemRequest := CreateDatabaseItemRequest{
@@ -128,3 +143,46 @@ func (a *populateAction) Invoke(ctx context.Context, req action.InvokeRequest, r
 	}
 
 }
+
+// dryRun reports the change writing each configured item would make to the
+// database, without writing anything.
+func (a *populateAction) dryRun(config populateActionModel, resp *action.InvokeResponse) {
+	database_id := config.DatabaseId.ValueString()
+
+	existing, err := existingItemValues(a.client, database_id, "")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Database Items",
+			"Could not list items of database ID "+database_id+": "+err.Error(),
+		)
+		return
+	}
+
+	items := make([]DatabaseItem, 0, len(config.Items))
+	for _, item := range config.Items {
+		items = append(items, DatabaseItem{
+			Key:   item.Key.ValueString(),
+			Value: item.Value.ValueString(),
+		})
+	}
+
+	changes := diffItems(existing, items)
+	for _, change := range changes {
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("%s %s", change.Kind, change.Key),
+		})
+	}
+
+	counts := countItemChanges(changes)
+	summary := fmt.Sprintf("%d to create, %d to update, %d unchanged",
+		counts[itemChangeCreate], counts[itemChangeUpdate], counts[itemChangeUnchanged])
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Dry run of populating database %s: %s", database_id, summary),
+	})
+
+	resp.Diagnostics.AddWarning(
+		"Dry Run",
+		"No items were written to database ID "+database_id+". Populating it would make these changes: "+summary+".",
+	)
+}
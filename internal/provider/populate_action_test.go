@@ -0,0 +1,210 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccPopulateAction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		// Actions are only available in 1.14 and later
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.14.0"))),
+		},
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Creating the trigger invokes the action
+			{
+				Config: testAccPopulateActionConfig(false, "alice"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDatabaseItem("bobsdiscountcloudco_database.test", "users/alice", "alice"),
+				),
+			},
+			// A dry run does not write the new item
+			{
+				Config: testAccPopulateActionConfig(true, "alice", "bob"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDatabaseItem("bobsdiscountcloudco_database.test", "users/alice", "alice"),
+					testAccCheckNoDatabaseItem("bobsdiscountcloudco_database.test", "users/bob"),
+				),
+			},
+		},
+	})
+}
+
+func TestPopulateAction_DryRun(t *testing.T) {
+	databases := map[string]map[string]string{
+		"db-1": {
+			"users/alice": "old",
+			"users/bob":   "bob",
+		},
+	}
+	server := testCopyItemsServer(t, databases)
+
+	a := &populateAction{client: &Client{HostURL: server.URL, HTTPClient: server.Client()}}
+	ctx := context.Background()
+
+	schemaResp := &action.SchemaResponse{}
+	a.Schema(ctx, action.SchemaRequest{}, schemaResp)
+
+	itemType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"key":   tftypes.String,
+		"value": tftypes.String,
+	}}
+	item := func(key, value string) tftypes.Value {
+		return tftypes.NewValue(itemType, map[string]tftypes.Value{
+			"key":   tftypes.NewValue(tftypes.String, key),
+			"value": tftypes.NewValue(tftypes.String, value),
+		})
+	}
+
+	var progress []string
+	resp := &action.InvokeResponse{
+		SendProgress: func(event action.InvokeProgressEvent) {
+			progress = append(progress, event.Message)
+		},
+	}
+
+	a.Invoke(ctx, action.InvokeRequest{
+		Config: tfsdk.Config{
+			Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{
+				"id": tftypes.NewValue(tftypes.String, "db-1"),
+				"items": tftypes.NewValue(tftypes.List{ElementType: itemType}, []tftypes.Value{
+					item("users/alice", "alice"),
+					item("users/bob", "bob"),
+					item("users/carol", "carol"),
+				}),
+				"dry_run": tftypes.NewValue(tftypes.Bool, true),
+			}),
+			Schema: schemaResp.Schema,
+		},
+	}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	if resp.Diagnostics.WarningsCount() != 1 || resp.Diagnostics.Warnings()[0].Summary() != "Dry Run" {
+		t.Errorf("got diagnostics %v, want a Dry Run warning", resp.Diagnostics)
+	}
+
+	expectedProgress := []string{
+		"update users/alice",
+		"unchanged users/bob",
+		"create users/carol",
+		"Dry run of populating database db-1: 1 to create, 1 to update, 1 unchanged",
+	}
+	if !reflect.DeepEqual(progress, expectedProgress) {
+		t.Errorf("got progress %q, want %q", progress, expectedProgress)
+	}
+
+	expectedItems := map[string]string{
+		"users/alice": "old",
+		"users/bob":   "bob",
+	}
+	if !reflect.DeepEqual(databases["db-1"], expectedItems) {
+		t.Errorf("got items %v, want them unchanged %v", databases["db-1"], expectedItems)
+	}
+}
+
+// testAccPopulateActionConfig populates a database with a users/<name> item
+// for each of names whenever the list of names changes.
+func testAccPopulateActionConfig(dryRun bool, names ...string) string {
+	items := ""
+	for _, name := range names {
+		items += fmt.Sprintf("      { key = \"users/%[1]s\", value = %[1]q },\n", name)
+	}
+
+	return testAccProviderConfig() + fmt.Sprintf(`
+resource "bobsdiscountcloudco_database" "test" {
+  name                = "tf-acc-populate"
+  deletion_protection = false
+}
+
+action "bobsdiscountcloudco_population_action" "test" {
+  config {
+    id      = bobsdiscountcloudco_database.test.id
+    dry_run = %[1]t
+    items = [
+%[2]s    ]
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = %[3]d
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create, after_update]
+      actions = [action.bobsdiscountcloudco_population_action.test]
+    }
+  }
+}
+`, dryRun, items, len(names))
+}
+
+// testAccCheckDatabaseItem checks that the database of a resource holds an
+// item with the given key and value.
+func testAccCheckDatabaseItem(name, key, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource %s not found in state", name)
+		}
+
+		client, err := testAccClient()
+		if err != nil {
+			return err
+		}
+
+		item, err := client.GetDatabaseItem(rs.Primary.ID, key)
+		if err != nil {
+			return err
+		}
+		if item.Value != value {
+			return fmt.Errorf("item %s has value %q, want %q", key, item.Value, value)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckNoDatabaseItem checks that the database of a resource does not
+// hold an item with the given key.
+func testAccCheckNoDatabaseItem(name, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource %s not found in state", name)
+		}
+
+		client, err := testAccClient()
+		if err != nil {
+			return err
+		}
+
+		_, err = client.GetDatabaseItem(rs.Primary.ID, key)
+		if IsNotFound(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("item %s exists, want it not written", key)
+	}
+}
@@ -6,6 +6,9 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -16,7 +19,10 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ provider.Provider = &bdccProvider{}
+	_ provider.Provider                       = &bdccProvider{}
+	_ provider.ProviderWithEphemeralResources = &bdccProvider{}
+	_ provider.ProviderWithFunctions          = &bdccProvider{}
+	_ provider.ProviderWithListResources      = &bdccProvider{}
 )
 
 // New is a helper function to simplify provider server and testing implementation.
@@ -53,14 +59,20 @@ func (p *bdccProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp
 				Required:  true,
 				Sensitive: true,
 			},
+			"default_tags": schema.MapAttribute{
+				Description: "Tags applied to every resource that supports tagging. Tags set on a resource take precedence.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
 		},
 	}
 }
 
 // hashicupsProviderModel maps provider schema data to a Go type.
 type hashicupsProviderModel struct {
-	Host   types.String `tfsdk:"host"`
-	ApiKey types.String `tfsdk:"api_key"`
+	Host        types.String `tfsdk:"host"`
+	ApiKey      types.String `tfsdk:"api_key"`
+	DefaultTags types.Map    `tfsdk:"default_tags"`
 }
 
 func (p *bdccProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
@@ -95,6 +107,15 @@ func (p *bdccProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 		)
 	}
 
+	if config.DefaultTags.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("default_tags"),
+			"Unknown Default Tags",
+			"The provider cannot apply default tags as there is an unknown configuration value for default_tags. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -136,6 +157,9 @@ func (p *bdccProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 		)
 	}
 
+	defaultTags, diags := tagsFromMap(ctx, config.DefaultTags)
+	resp.Diagnostics.Append(diags...)
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -157,11 +181,15 @@ func (p *bdccProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 		)
 		return
 	}
+	client.DefaultTags = defaultTags
+	client.UserAgent = UserAgent(p.version, req.TerraformVersion)
 
 	// Make the HashiCups client available during DataSource and Resource
 	// type Configure methods.
 	resp.DataSourceData = client
 	resp.ResourceData = client
+	resp.EphemeralResourceData = client
+	resp.ListResourceData = client
 	resp.ActionData = client
 
 	tflog.Info(ctx, "Configured BobsDiscountCloudCo client", map[string]any{"success": true})
@@ -171,12 +199,25 @@ func (p *bdccProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 func (p *bdccProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewBdccDataSource,
+		NewDatabaseSnapshotsDataSource,
 	}
 }
 
 func (p *bdccProvider) Actions(_ context.Context) []func() action.Action {
 	return []func() action.Action{
 		NewPopulateAction,
+		NewRestoreAction,
+		NewExportAction,
+		NewTruncateAction,
+		NewCopyItemsAction,
+	}
+}
+
+// EphemeralResources defines the ephemeral resources implemented in the provider.
+func (p *bdccProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewDatabaseCredentialsEphemeralResource,
+		NewConnectionStringEphemeralResource,
 	}
 }
 
@@ -184,5 +225,24 @@ func (p *bdccProvider) Actions(_ context.Context) []func() action.Action {
 func (p *bdccProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewDatabaseResource,
+		NewDatabaseItemResource,
+		NewDatabaseSnapshotResource,
+	}
+}
+
+// ListResources defines the list resources implemented in the provider.
+func (p *bdccProvider) ListResources(_ context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		NewDatabaseListResource,
+		NewDatabaseItemListResource,
+	}
+}
+
+// Functions defines the provider-defined functions implemented in the provider.
+func (p *bdccProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewParseDatabaseIDFunction,
+		NewBuildEndpointFunction,
+		NewItemKeyFunction,
 	}
 }
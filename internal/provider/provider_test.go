@@ -4,31 +4,77 @@
 package provider
 
 import (
+	"fmt"
+	"net/http/httptest"
+	"os"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"terraform-provider-hashicups/internal/fakebdcc"
 )
 
+// testAccFakeAPIKey is the API key of the fake API the tests run against
+// when HASHICUPS_HOST is not set.
+const testAccFakeAPIKey = "tf-acc-fake-api-key"
+
+// TestMain runs the sweepers when -sweep is set. Otherwise acceptance tests
+// run against an in-memory fake of the Bob's API, unless HASHICUPS_HOST
+// points them at a real endpoint.
+func TestMain(m *testing.M) {
+	if os.Getenv("TF_ACC") != "" && os.Getenv("HASHICUPS_HOST") == "" {
+		// resource.TestMain exits the process, which stops the server.
+		server := httptest.NewServer(fakebdcc.New(fakebdcc.Options{APIKey: testAccFakeAPIKey}))
+		os.Setenv("HASHICUPS_HOST", server.URL)
+		os.Setenv("HASHICUPS_API_KEY", testAccFakeAPIKey)
+	}
+
+	resource.TestMain(m)
+}
+
 // testAccProtoV6ProviderFactories is used to instantiate a provider during acceptance testing.
 // The factory function is called for each Terraform CLI command to create a provider
 // server that the CLI can connect to and interact with.
 var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
-	"scaffolding": providerserver.NewProtocol6WithError(New("test")()),
+	"bobsdiscountcloudco": providerserver.NewProtocol6WithError(New("test")()),
 }
 
-// testAccProtoV6ProviderFactoriesWithEcho includes the echo provider alongside the scaffolding provider.
+// testAccProtoV6ProviderFactoriesWithEcho includes the echo provider alongside the bobsdiscountcloudco provider.
 // It allows for testing assertions on data returned by an ephemeral resource during Open.
 // The echoprovider is used to arrange tests by echoing ephemeral data into the Terraform state.
 // This lets the data be referenced in test assertions with state checks.
 var testAccProtoV6ProviderFactoriesWithEcho = map[string]func() (tfprotov6.ProviderServer, error){
-	"scaffolding": providerserver.NewProtocol6WithError(New("test")()),
-	"echo":        echoprovider.NewProviderServer(),
+	"bobsdiscountcloudco": providerserver.NewProtocol6WithError(New("test")()),
+	"echo":                echoprovider.NewProviderServer(),
 }
 
 func testAccPreCheck(t *testing.T) {
-	// You can add code here to run prior to any test case execution, for example assertions
-	// about the appropriate environment variables being set are common to see in a pre-check
-	// function.
+	for _, name := range []string{"HASHICUPS_HOST", "HASHICUPS_API_KEY"} {
+		if os.Getenv(name) == "" {
+			t.Fatalf("%s must be set for acceptance tests", name)
+		}
+	}
+}
+
+// testAccProviderConfig returns a provider block configured from the
+// environment variables checked in testAccPreCheck.
+func testAccProviderConfig() string {
+	return fmt.Sprintf(`
+provider "bobsdiscountcloudco" {
+  host    = %[1]q
+  api_key = %[2]q
+}
+`, os.Getenv("HASHICUPS_HOST"), os.Getenv("HASHICUPS_API_KEY"))
+}
+
+// testAccClient returns a client for the API the acceptance tests run
+// against, for checking and changing resources outside of Terraform.
+func testAccClient() (*Client, error) {
+	host := os.Getenv("HASHICUPS_HOST")
+	api_key := os.Getenv("HASHICUPS_API_KEY")
+
+	return NewClient(&host, &api_key)
 }
@@ -0,0 +1,197 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	// restoreStatusPending and restoreStatusRunning are the statuses of a
+	// restore that has not finished yet.
+	restoreStatusPending = "pending"
+	restoreStatusRunning = "running"
+
+	// restoreStatusCompleted is the status of a restore that has finished. A
+	// failed restore reports statusFailed.
+	restoreStatusCompleted = "completed"
+)
+
+func NewRestoreAction() action.Action {
+	return &restoreAction{}
+}
+
+type restoreAction struct {
+	client *Client
+}
+
+var (
+	_ action.Action              = (*restoreAction)(nil)
+	_ action.ActionWithConfigure = (*restoreAction)(nil)
+)
+
+// Configure adds the provider configured client to the action.
+func (a *restoreAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *provider.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.client = client
+}
+
+type restoreActionModel struct {
+	DatabaseId       types.String `tfsdk:"database_id"`
+	SnapshotId       types.String `tfsdk:"snapshot_id"`
+	TargetDatabaseId types.String `tfsdk:"target_database_id"`
+	TargetName       types.String `tfsdk:"target_name"`
+}
+
+func (a *restoreAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_restore_action"
+}
+
+func (a *restoreAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Restores a snapshot of a database, either in place or into another database, and waits for the restore to finish. Restoring in place or into an existing database replaces all of its items.",
+		Attributes: map[string]schema.Attribute{
+			"database_id": schema.StringAttribute{
+				Description: "ID of the database the snapshot was taken of.",
+				Required:    true,
+			},
+			"snapshot_id": schema.StringAttribute{
+				Description: "ID of the snapshot to restore.",
+				Required:    true,
+			},
+			"target_database_id": schema.StringAttribute{
+				Description: "ID of an existing database to restore into. Defaults to restoring `database_id` in place.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("target_name")),
+				},
+			},
+			"target_name": schema.StringAttribute{
+				Description: "Name of a new database to restore into. The new database is not managed by Terraform.",
+				Optional:    true,
+				Validators:  databaseNameValidators(),
+			},
+		},
+	}
+}
+
+func (a *restoreAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config restoreActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	database_id := config.DatabaseId.ValueString()
+	snapshot_id := config.SnapshotId.ValueString()
+
+	restore, err := a.client.RestoreDatabase(RestoreDatabaseRequest{
+		SnapshotId:       snapshot_id,
+		TargetDatabaseId: config.TargetDatabaseId.ValueString(),
+		TargetName:       config.TargetName.ValueString(),
+	}, database_id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Starting Restore",
+			"Could not restore snapshot ID "+snapshot_id+" of database ID "+database_id+": "+err.Error(),
+		)
+		return
+	}
+
+	target := restore.TargetDatabaseId
+	if target == "" {
+		target = database_id
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Restoring snapshot %s into database %s", snapshot_id, target),
+	})
+
+	restore, err = waitForRestore(ctx, a.client, resp, database_id, restore)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Restoring Database",
+			"Restore of snapshot ID "+snapshot_id+" into database ID "+target+" did not complete: "+err.Error(),
+		)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Restored %d items from snapshot %s into database %s", restore.ItemsRestored, snapshot_id, target),
+	})
+}
+
+// waitForRestore polls a restore until it completes, sending a progress
+// event whenever its progress changes. It fails on a status it does not
+// know, rather than polling until databaseWaitTimeout.
+func waitForRestore(ctx context.Context, client *Client, resp *action.InvokeResponse, database_id string, restore *DatabaseRestore) (*DatabaseRestore, error) {
+	ctx, cancel := context.WithTimeout(ctx, databaseWaitTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(databasePollInterval)
+	defer ticker.Stop()
+
+	lastPercent := int64(-1)
+
+	for {
+		// Like isAvailable, an empty status is treated as completed, for
+		// services that do not report one.
+		switch restore.Status {
+		case "", restoreStatusCompleted:
+			return restore, nil
+		case statusFailed:
+			if restore.Error != "" {
+				return nil, fmt.Errorf("restore %s failed: %s", restore.Id, restore.Error)
+			}
+			return nil, fmt.Errorf("restore %s failed", restore.Id)
+		case restoreStatusPending, restoreStatusRunning:
+		default:
+			return nil, fmt.Errorf("restore %s has unexpected status %q", restore.Id, restore.Status)
+		}
+
+		if restore.ProgressPercent != lastPercent {
+			lastPercent = restore.ProgressPercent
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("Restore %s is %s: %d%% done, %d items restored", restore.Id, restore.Status, restore.ProgressPercent, restore.ItemsRestored),
+			})
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("restore %s is still %s: %w", restore.Id, restore.Status, ctx.Err())
+		case <-ticker.C:
+		}
+
+		var err error
+		restore, err = client.GetDatabaseRestore(database_id, restore.Id)
+		if err != nil {
+			return nil, err
+		}
+	}
+}
@@ -0,0 +1,168 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestRestoreAction_Invoke(t *testing.T) {
+	defaultInterval := databasePollInterval
+	databasePollInterval = time.Millisecond
+	t.Cleanup(func() { databasePollInterval = defaultInterval })
+
+	cases := map[string]struct {
+		target         map[string]tftypes.Value
+		polls          []DatabaseRestore
+		expectRequest  RestoreDatabaseRequest
+		expectError    string
+		expectProgress []string
+	}{
+		"in place": {
+			polls: []DatabaseRestore{
+				{Status: "running", ProgressPercent: 50, ItemsRestored: 10},
+				{Status: "running", ProgressPercent: 50, ItemsRestored: 10},
+				{Status: "completed", ProgressPercent: 100, ItemsRestored: 20},
+			},
+			expectRequest: RestoreDatabaseRequest{SnapshotId: "snap-1"},
+			expectProgress: []string{
+				"Restoring snapshot snap-1 into database db-1",
+				"Restore restore-1 is pending: 0% done, 0 items restored",
+				"Restore restore-1 is running: 50% done, 10 items restored",
+				"Restored 20 items from snapshot snap-1 into database db-1",
+			},
+		},
+		"new target": {
+			target: map[string]tftypes.Value{
+				"target_name": tftypes.NewValue(tftypes.String, "orders-restored"),
+			},
+			polls: []DatabaseRestore{
+				{Status: "completed", ProgressPercent: 100, ItemsRestored: 5},
+			},
+			expectRequest: RestoreDatabaseRequest{SnapshotId: "snap-1", TargetName: "orders-restored"},
+			expectProgress: []string{
+				"Restoring snapshot snap-1 into database db-2",
+				"Restore restore-1 is pending: 0% done, 0 items restored",
+				"Restored 5 items from snapshot snap-1 into database db-2",
+			},
+		},
+		"no status": {
+			polls: []DatabaseRestore{
+				{ItemsRestored: 7},
+			},
+			expectRequest: RestoreDatabaseRequest{SnapshotId: "snap-1"},
+			expectProgress: []string{
+				"Restoring snapshot snap-1 into database db-1",
+				"Restore restore-1 is pending: 0% done, 0 items restored",
+				"Restored 7 items from snapshot snap-1 into database db-1",
+			},
+		},
+		"unknown status": {
+			polls: []DatabaseRestore{
+				{Status: "paused"},
+			},
+			expectRequest: RestoreDatabaseRequest{SnapshotId: "snap-1"},
+			expectError:   `restore restore-1 has unexpected status "paused"`,
+		},
+		"failed": {
+			polls: []DatabaseRestore{
+				{Status: "failed", Error: "snapshot expired"},
+			},
+			expectRequest: RestoreDatabaseRequest{SnapshotId: "snap-1"},
+			expectError:   "restore restore-1 failed: snapshot expired",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got RestoreDatabaseRequest
+			var polls int
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == "POST" {
+					_ = json.NewDecoder(r.Body).Decode(&got)
+				}
+
+				// A named target is restored into a new database.
+				target := "db-1"
+				if got.TargetName != "" {
+					target = "db-2"
+				}
+
+				switch {
+				case r.Method == "POST" && r.URL.Path == "/database/db-1/restores":
+					_ = json.NewEncoder(w).Encode(DatabaseRestore{Id: "restore-1", TargetDatabaseId: target, Status: "pending"})
+				case r.Method == "GET" && r.URL.Path == "/database/db-1/restores/restore-1":
+					restore := tc.polls[min(polls, len(tc.polls)-1)]
+					polls++
+					restore.Id = "restore-1"
+					restore.TargetDatabaseId = target
+					_ = json.NewEncoder(w).Encode(restore)
+				default:
+					http.NotFound(w, r)
+				}
+			}))
+			t.Cleanup(server.Close)
+
+			a := &restoreAction{client: &Client{HostURL: server.URL, HTTPClient: server.Client()}}
+			ctx := context.Background()
+
+			schemaResp := &action.SchemaResponse{}
+			a.Schema(ctx, action.SchemaRequest{}, schemaResp)
+
+			config := map[string]tftypes.Value{
+				"database_id":        tftypes.NewValue(tftypes.String, "db-1"),
+				"snapshot_id":        tftypes.NewValue(tftypes.String, "snap-1"),
+				"target_database_id": tftypes.NewValue(tftypes.String, nil),
+				"target_name":        tftypes.NewValue(tftypes.String, nil),
+			}
+			for k, v := range tc.target {
+				config[k] = v
+			}
+
+			var progress []string
+			resp := &action.InvokeResponse{
+				SendProgress: func(event action.InvokeProgressEvent) {
+					progress = append(progress, event.Message)
+				},
+			}
+
+			a.Invoke(ctx, action.InvokeRequest{
+				Config: tfsdk.Config{
+					Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), config),
+					Schema: schemaResp.Schema,
+				},
+			}, resp)
+
+			if got != tc.expectRequest {
+				t.Errorf("got request %+v, want %+v", got, tc.expectRequest)
+			}
+
+			if tc.expectError != "" {
+				if !resp.Diagnostics.HasError() || !strings.Contains(resp.Diagnostics.Errors()[0].Detail(), tc.expectError) {
+					t.Fatalf("got diagnostics %v, want error containing %q", resp.Diagnostics, tc.expectError)
+				}
+				return
+			}
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			if strings.Join(progress, "\n") != strings.Join(tc.expectProgress, "\n") {
+				t.Errorf("got progress:\n%s\nwant:\n%s", strings.Join(progress, "\n"), strings.Join(tc.expectProgress, "\n"))
+			}
+		})
+	}
+}
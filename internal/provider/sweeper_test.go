@@ -0,0 +1,232 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"terraform-provider-hashicups/internal/fakebdcc"
+)
+
+// testAccNamePrefix starts the name of every database created by the
+// acceptance tests. Sweepers only delete databases with this prefix.
+const testAccNamePrefix = "tf-acc-"
+
+func init() {
+	resource.AddTestSweepers("bobsdiscountcloudco_database", &resource.Sweeper{
+		Name: "bobsdiscountcloudco_database",
+		Dependencies: []string{
+			"bobsdiscountcloudco_database_snapshot",
+			"bobsdiscountcloudco_database_credentials",
+		},
+		F: sweepDatabases,
+	})
+
+	resource.AddTestSweepers("bobsdiscountcloudco_database_snapshot", &resource.Sweeper{
+		Name: "bobsdiscountcloudco_database_snapshot",
+		F:    sweepDatabaseSnapshots,
+	})
+
+	resource.AddTestSweepers("bobsdiscountcloudco_database_credentials", &resource.Sweeper{
+		Name: "bobsdiscountcloudco_database_credentials",
+		F:    sweepDatabaseCredentials,
+	})
+}
+
+// sweeperClient returns a client for the API serving region. HASHICUPS_HOST
+// overrides the regional endpoint.
+func sweeperClient(region string) (*Client, error) {
+	host := os.Getenv("HASHICUPS_HOST")
+	if host == "" {
+		var err error
+		host, err = buildEndpoint(region)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	api_key := os.Getenv("HASHICUPS_API_KEY")
+	if api_key == "" {
+		return nil, fmt.Errorf("HASHICUPS_API_KEY must be set for sweepers")
+	}
+
+	return NewClient(&host, &api_key)
+}
+
+// sweepableDatabases lists the databases created by the acceptance tests.
+func sweepableDatabases(client *Client) ([]Database, error) {
+	databases, err := client.ListAllDatabases(ListDatabasesRequest{NamePrefix: testAccNamePrefix})
+	if err != nil {
+		return nil, fmt.Errorf("listing databases: %w", err)
+	}
+
+	// Filter again in case the API ignores the prefix.
+	var sweepable []Database
+	for _, database := range databases {
+		if strings.HasPrefix(database.Name, testAccNamePrefix) {
+			sweepable = append(sweepable, database)
+		}
+	}
+
+	return sweepable, nil
+}
+
+func sweepDatabases(region string) error {
+	client, err := sweeperClient(region)
+	if err != nil {
+		return err
+	}
+
+	databases, err := sweepableDatabases(client)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, database := range databases {
+		log.Printf("[INFO] Deleting database %s (%s)", database.Name, database.Id)
+
+		if err := sweepDatabase(client, database.Id); err != nil && !IsNotFound(err) {
+			errs = append(errs, fmt.Errorf("deleting database %s: %w", database.Id, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// sweepDatabase disables the deletion protection of a database, then
+// deletes it.
+func sweepDatabase(client *Client, database_id string) error {
+	database, err := client.GetDatabase(database_id)
+	if err != nil {
+		return err
+	}
+
+	if database.DeletionProtection == nil || *database.DeletionProtection {
+		_, err := client.UpdateDatabase(UpdateDatabaseRequest{
+			Name:               database.Name,
+			DeletionProtection: false,
+			Tags:               database.Tags,
+		}, database_id)
+		if err != nil {
+			return fmt.Errorf("disabling deletion protection: %w", err)
+		}
+	}
+
+	return client.DeleteDatabase(database_id)
+}
+
+func sweepDatabaseSnapshots(region string) error {
+	client, err := sweeperClient(region)
+	if err != nil {
+		return err
+	}
+
+	databases, err := sweepableDatabases(client)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, database := range databases {
+		snapshots, err := client.ListAllDatabaseSnapshots(database.Id)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("listing snapshots of database %s: %w", database.Id, err))
+			continue
+		}
+
+		for _, snapshot := range snapshots {
+			log.Printf("[INFO] Deleting snapshot %s of database %s", snapshot.Id, database.Name)
+
+			if err := client.DeleteDatabaseSnapshot(database.Id, snapshot.Id); err != nil && !IsNotFound(err) {
+				errs = append(errs, fmt.Errorf("deleting snapshot %s of database %s: %w", snapshot.Id, database.Id, err))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func sweepDatabaseCredentials(region string) error {
+	client, err := sweeperClient(region)
+	if err != nil {
+		return err
+	}
+
+	databases, err := sweepableDatabases(client)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, database := range databases {
+		credentials, err := client.ListDatabaseCredentials(database.Id)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("listing credentials of database %s: %w", database.Id, err))
+			continue
+		}
+
+		for _, credential := range credentials {
+			log.Printf("[INFO] Revoking credential %s of database %s", credential.Id, database.Name)
+
+			if err := client.RevokeDatabaseCredential(database.Id, credential.Id); err != nil && !IsNotFound(err) {
+				errs = append(errs, fmt.Errorf("revoking credential %s of database %s: %w", credential.Id, database.Id, err))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func TestSweepers(t *testing.T) {
+	server := httptest.NewServer(fakebdcc.New(fakebdcc.Options{APIKey: testAccFakeAPIKey}))
+	t.Cleanup(server.Close)
+
+	t.Setenv("HASHICUPS_HOST", server.URL)
+	t.Setenv("HASHICUPS_API_KEY", testAccFakeAPIKey)
+
+	client, err := sweeperClient("us-east-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	leaked, err := client.CreateDatabase(CreateDatabaseRequest{Name: testAccNamePrefix + "leaked", DeletionProtection: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, err := client.CreateDatabaseSnapshot(CreateDatabaseSnapshotRequest{}, leaked.Id); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, err := client.CreateDatabaseCredential(CreateDatabaseCredentialRequest{Scope: "read"}, leaked.Id); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	kept, err := client.CreateDatabase(CreateDatabaseRequest{Name: "orders"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// Run in dependency order, as the sweeper runner does.
+	for _, sweep := range []resource.SweeperFunc{sweepDatabaseCredentials, sweepDatabaseSnapshots, sweepDatabases} {
+		if err := sweep("us-east-1"); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	databases, err := client.ListAllDatabases(ListDatabasesRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(databases) != 1 || databases[0].Id != kept.Id {
+		t.Errorf("got databases %+v, want only %s kept", databases, kept.Id)
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// mergeTags returns the provider default tags overlaid with the resource
+// tags. Resource tags win when both define the same key.
+func mergeTags(defaults, tags map[string]string) map[string]string {
+	merged := make(map[string]string, len(defaults)+len(tags))
+
+	for k, v := range defaults {
+		merged[k] = v
+	}
+
+	for k, v := range tags {
+		merged[k] = v
+	}
+
+	return merged
+}
+
+// tagsMatch reports whether tags contains every key/value pair in filter.
+func tagsMatch(filter, tags map[string]string) bool {
+	for k, v := range filter {
+		if tv, ok := tags[k]; !ok || tv != v {
+			return false
+		}
+	}
+
+	return true
+}
+
+// tagsFromMap converts a tags attribute into a Go map. Null and unknown
+// values convert to a nil map.
+func tagsFromMap(ctx context.Context, value types.Map) (map[string]string, diag.Diagnostics) {
+	if value.IsNull() || value.IsUnknown() {
+		return nil, nil
+	}
+
+	tags := make(map[string]string, len(value.Elements()))
+	diags := value.ElementsAs(ctx, &tags, false)
+
+	return tags, diags
+}
+
+// tagsToMap converts API tags into a tags attribute value.
+func tagsToMap(ctx context.Context, tags map[string]string) (types.Map, diag.Diagnostics) {
+	if tags == nil {
+		tags = map[string]string{}
+	}
+
+	return types.MapValueFrom(ctx, types.StringType, tags)
+}
@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMergeTags(t *testing.T) {
+	cases := map[string]struct {
+		defaults, tags, want map[string]string
+	}{
+		"both nil": {want: map[string]string{}},
+		"defaults only": {
+			defaults: map[string]string{"owner": "platform"},
+			want:     map[string]string{"owner": "platform"},
+		},
+		"resource tags win": {
+			defaults: map[string]string{"owner": "platform", "environment": "dev"},
+			tags:     map[string]string{"environment": "prod", "cost-center": "42"},
+			want:     map[string]string{"owner": "platform", "environment": "prod", "cost-center": "42"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := mergeTags(tc.defaults, tc.tags); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestTagsMatch(t *testing.T) {
+	tags := map[string]string{"owner": "platform", "environment": "prod"}
+
+	cases := map[string]struct {
+		filter map[string]string
+		want   bool
+	}{
+		"empty filter": {filter: nil, want: true},
+		"subset":       {filter: map[string]string{"owner": "platform"}, want: true},
+		"exact":        {filter: tags, want: true},
+		"wrong value":  {filter: map[string]string{"environment": "dev"}, want: false},
+		"missing key":  {filter: map[string]string{"team": "db"}, want: false},
+		"empty value":  {filter: map[string]string{"team": ""}, want: false},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := tagsMatch(tc.filter, tags); got != tc.want {
+				t.Errorf("got %t, want %t", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,163 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// truncateBatchSize is the number of items deleted per request.
+const truncateBatchSize = 100
+
+func NewTruncateAction() action.Action {
+	return &truncateAction{}
+}
+
+type truncateAction struct {
+	client *Client
+}
+
+var (
+	_ action.Action              = (*truncateAction)(nil)
+	_ action.ActionWithConfigure = (*truncateAction)(nil)
+)
+
+// Configure adds the provider configured client to the action.
+func (a *truncateAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *provider.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.client = client
+}
+
+type truncateActionModel struct {
+	DatabaseId types.String `tfsdk:"database_id"`
+	KeyPrefix  types.String `tfsdk:"key_prefix"`
+	Confirm    types.String `tfsdk:"confirm"`
+}
+
+func (a *truncateAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_truncate_action"
+}
+
+func (a *truncateAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Deletes every item of a database, or every item whose key starts with a prefix. The database itself is kept.",
+		Attributes: map[string]schema.Attribute{
+			"database_id": schema.StringAttribute{
+				Description: "ID of the database to truncate.",
+				Required:    true,
+			},
+			"key_prefix": schema.StringAttribute{
+				Description: "Only delete items whose key starts with this prefix.",
+				Optional:    true,
+			},
+			"confirm": schema.StringAttribute{
+				Description: "Name of the database. The action refuses to run unless it matches, to guard against truncating the wrong database.",
+				Required:    true,
+			},
+		},
+	}
+}
+
+func (a *truncateAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config truncateActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	database_id := config.DatabaseId.ValueString()
+
+	database, err := a.client.GetDatabase(database_id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Database",
+			"Could not read database ID "+database_id+": "+err.Error(),
+		)
+		return
+	}
+
+	if config.Confirm.ValueString() != database.Name {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("confirm"),
+			"Truncate Not Confirmed",
+			fmt.Sprintf("confirm must be set to the name of database ID %s, %q, got %q. No items were deleted.", database_id, database.Name, config.Confirm.ValueString()),
+		)
+		return
+	}
+
+	var total int64
+
+	// Deleted items drop out of the listing, so always read the first page.
+	for {
+		page, err := a.client.ListDatabaseItems(ListDatabaseItemsRequest{
+			Prefix: config.KeyPrefix.ValueString(),
+			Limit:  truncateBatchSize,
+		}, database_id)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Listing Database Items",
+				fmt.Sprintf("Could not list items of database ID %s after deleting %d items: %s", database_id, total, err),
+			)
+			return
+		}
+
+		if len(page.Items) == 0 {
+			break
+		}
+
+		keys := make([]string, 0, len(page.Items))
+		for _, item := range page.Items {
+			keys = append(keys, item.Key)
+		}
+
+		deleted, err := a.client.DeleteDatabaseItems(DeleteDatabaseItemsRequest{Keys: keys}, database_id)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Deleting Database Items",
+				fmt.Sprintf("Could not delete items of database ID %s after deleting %d items: %s", database_id, total, err),
+			)
+			return
+		}
+
+		// Guard against looping forever on items the service will not delete.
+		if deleted.Deleted == 0 {
+			resp.Diagnostics.AddError(
+				"Error Deleting Database Items",
+				fmt.Sprintf("Database ID %s did not delete any of %d listed items after deleting %d items.", database_id, len(keys), total),
+			)
+			return
+		}
+
+		total += deleted.Deleted
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Deleted %d items from database %s", total, database.Name),
+		})
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Truncated database %s: %d items deleted", database.Name, total),
+	})
+}
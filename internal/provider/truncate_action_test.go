@@ -0,0 +1,160 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// testTruncateServer serves a database named orders holding items.
+func testTruncateServer(t *testing.T, items map[string]string) *httptest.Server {
+	t.Helper()
+
+	var mu sync.Mutex
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/database/db-1":
+			_ = json.NewEncoder(w).Encode(CreateDatabaseResponse{Id: "db-1", Name: "orders"})
+		case r.Method == "GET" && r.URL.Path == "/database/db-1/items":
+			limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+			var keys []string
+			for k := range items {
+				if strings.HasPrefix(k, r.URL.Query().Get("prefix")) {
+					keys = append(keys, k)
+				}
+			}
+			sort.Strings(keys)
+
+			page := ListDatabaseItemsResponse{Items: []DatabaseItem{}}
+			for _, k := range keys {
+				if limit > 0 && len(page.Items) == limit {
+					page.NextToken = "more"
+					break
+				}
+				page.Items = append(page.Items, DatabaseItem{Key: k, Value: items[k]})
+			}
+			_ = json.NewEncoder(w).Encode(page)
+		case r.Method == "POST" && r.URL.Path == "/database/db-1/delete-items":
+			var req DeleteDatabaseItemsRequest
+			_ = json.NewDecoder(r.Body).Decode(&req)
+
+			var deleted int64
+			for _, k := range req.Keys {
+				if _, ok := items[k]; ok {
+					delete(items, k)
+					deleted++
+				}
+			}
+			_ = json.NewEncoder(w).Encode(DeleteDatabaseItemsResponse{Deleted: deleted})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestTruncateAction_Invoke(t *testing.T) {
+	sessions := "sessions/"
+
+	cases := map[string]struct {
+		keyPrefix     *string
+		confirm       string
+		expectError   string
+		expectDeleted int
+	}{
+		"all items": {
+			confirm:       "orders",
+			expectDeleted: 250,
+		},
+		"key prefix": {
+			keyPrefix:     &sessions,
+			confirm:       "orders",
+			expectDeleted: 150,
+		},
+		"wrong confirmation": {
+			confirm:     "orders-staging",
+			expectError: "Truncate Not Confirmed",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			items := map[string]string{}
+			for i := 0; i < 150; i++ {
+				items[fmt.Sprintf("sessions/%03d", i)] = "session"
+			}
+			for i := 0; i < 100; i++ {
+				items[fmt.Sprintf("users/%03d", i)] = "user"
+			}
+			server := testTruncateServer(t, items)
+
+			a := &truncateAction{client: &Client{HostURL: server.URL, HTTPClient: server.Client()}}
+			ctx := context.Background()
+
+			schemaResp := &action.SchemaResponse{}
+			a.Schema(ctx, action.SchemaRequest{}, schemaResp)
+
+			var progress []string
+			resp := &action.InvokeResponse{
+				SendProgress: func(event action.InvokeProgressEvent) {
+					progress = append(progress, event.Message)
+				},
+			}
+
+			a.Invoke(ctx, action.InvokeRequest{
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{
+						"database_id": tftypes.NewValue(tftypes.String, "db-1"),
+						"key_prefix":  tftypes.NewValue(tftypes.String, tc.keyPrefix),
+						"confirm":     tftypes.NewValue(tftypes.String, tc.confirm),
+					}),
+					Schema: schemaResp.Schema,
+				},
+			}, resp)
+
+			if tc.expectError != "" {
+				if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != tc.expectError {
+					t.Fatalf("got diagnostics %v, want %q", resp.Diagnostics, tc.expectError)
+				}
+				if len(items) != 250 {
+					t.Errorf("got %d items left, want all 250 kept", len(items))
+				}
+				return
+			}
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			if got := 250 - len(items); got != tc.expectDeleted {
+				t.Errorf("got %d items deleted, want %d", got, tc.expectDeleted)
+			}
+
+			expected := fmt.Sprintf("Truncated database orders: %d items deleted", tc.expectDeleted)
+			if last := progress[len(progress)-1]; last != expected {
+				t.Errorf("got final progress %q, want %q", last, expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// databaseNameValidators enforce Bob's database naming rules.
+func databaseNameValidators() []validator.String {
+	return []validator.String{
+		stringvalidator.LengthBetween(databaseNameMinLength, databaseNameMaxLength),
+		stringvalidator.RegexMatches(
+			databaseNamePattern,
+			"must contain only lowercase letters, digits and hyphens, start with a letter and not end with a hyphen",
+		),
+		stringvalidator.NoneOf(reservedDatabaseNames...),
+	}
+}
+
+// itemKeyValidators enforce Bob's item key rules.
+func itemKeyValidators() []validator.String {
+	return []validator.String{
+		stringvalidator.LengthBetween(1, itemKeyMaxLength),
+		stringvalidator.RegexMatches(
+			itemKeyPattern,
+			"must be one or more parts of letters, digits, '_', '.' and '-' separated by '/'",
+		),
+	}
+}
+
+// itemValueValidators enforce Bob's item value rules.
+func itemValueValidators() []validator.String {
+	return []validator.String{
+		stringvalidator.LengthAtMost(itemValueMaxLength),
+	}
+}
+
+var _ validator.List = uniqueItemKeysValidator{}
+
+// uniqueItemKeysValidator validates that no two objects in a list of items
+// share the same key attribute.
+type uniqueItemKeysValidator struct{}
+
+// uniqueItemKeys returns a validator which ensures every key in a list of
+// key/value items is unique. Unknown keys are ignored.
+func uniqueItemKeys() validator.List {
+	return uniqueItemKeysValidator{}
+}
+
+func (v uniqueItemKeysValidator) Description(_ context.Context) string {
+	return "item keys must be unique"
+}
+
+func (v uniqueItemKeysValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v uniqueItemKeysValidator) ValidateList(_ context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	seen := make(map[string]int)
+
+	for i, element := range req.ConfigValue.Elements() {
+		item, ok := element.(types.Object)
+		if !ok || item.IsNull() || item.IsUnknown() {
+			continue
+		}
+
+		key, ok := item.Attributes()["key"].(types.String)
+		if !ok || key.IsNull() || key.IsUnknown() {
+			continue
+		}
+
+		if first, duplicate := seen[key.ValueString()]; duplicate {
+			resp.Diagnostics.AddAttributeError(
+				req.Path.AtListIndex(i).AtName("key"),
+				"Duplicate Item Key",
+				fmt.Sprintf("The key %q is already used by the item at index %d. Item keys must be unique.", key.ValueString(), first),
+			)
+			continue
+		}
+
+		seen[key.ValueString()] = i
+	}
+}
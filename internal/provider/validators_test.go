@@ -0,0 +1,132 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func validateString(validators []validator.String, value string) bool {
+	req := validator.StringRequest{
+		Path:        path.Root("test"),
+		ConfigValue: types.StringValue(value),
+	}
+	resp := &validator.StringResponse{}
+
+	for _, v := range validators {
+		v.ValidateString(context.Background(), req, resp)
+	}
+
+	return !resp.Diagnostics.HasError()
+}
+
+func TestDatabaseNameValidators(t *testing.T) {
+	cases := map[string]bool{
+		"orders":                 true,
+		"orders-2024":            true,
+		"abc":                    true,
+		strings.Repeat("a", 63):  true,
+		"ab":                     false,
+		strings.Repeat("a", 64):  false,
+		"Orders":                 false,
+		"1orders":                false,
+		"orders-":                false,
+		"orders_db":              false,
+		"admin":                  false,
+		"system":                 false,
+		"":                       false,
+		"my orders":              false,
+		"orders.prod":            false,
+		"internal-orders":        true,
+		"bobs-discount-database": true,
+	}
+
+	for name, valid := range cases {
+		if got := validateString(databaseNameValidators(), name); got != valid {
+			t.Errorf("name %q: got valid=%t, want %t", name, got, valid)
+		}
+	}
+}
+
+func TestItemKeyValidators(t *testing.T) {
+	cases := map[string]bool{
+		"flags":                                 true,
+		"config/feature-flags/v1.2":             true,
+		strings.Repeat("k", itemKeyMaxLength):   true,
+		strings.Repeat("k", itemKeyMaxLength+1): false,
+		"":                                      false,
+		"/leading":                              false,
+		"trailing/":                             false,
+		"double//slash":                         false,
+		"with space":                            false,
+	}
+
+	for key, valid := range cases {
+		if got := validateString(itemKeyValidators(), key); got != valid {
+			t.Errorf("key %q: got valid=%t, want %t", key, got, valid)
+		}
+	}
+}
+
+func TestItemValueValidators(t *testing.T) {
+	if !validateString(itemValueValidators(), strings.Repeat("v", itemValueMaxLength)) {
+		t.Error("expected value at the size limit to be valid")
+	}
+
+	if validateString(itemValueValidators(), strings.Repeat("v", itemValueMaxLength+1)) {
+		t.Error("expected value over the size limit to be invalid")
+	}
+}
+
+func TestUniqueItemKeys(t *testing.T) {
+	itemType := types.ObjectType{AttrTypes: map[string]attr.Type{
+		"key":   types.StringType,
+		"value": types.StringType,
+	}}
+	item := func(key types.String) attr.Value {
+		return types.ObjectValueMust(itemType.AttrTypes, map[string]attr.Value{
+			"key":   key,
+			"value": types.StringValue("v"),
+		})
+	}
+
+	cases := map[string]struct {
+		items      []attr.Value
+		wantErrors int
+	}{
+		"unique": {
+			items: []attr.Value{item(types.StringValue("a")), item(types.StringValue("b"))},
+		},
+		"duplicate": {
+			items:      []attr.Value{item(types.StringValue("a")), item(types.StringValue("b")), item(types.StringValue("a"))},
+			wantErrors: 1,
+		},
+		"unknown keys ignored": {
+			items: []attr.Value{item(types.StringUnknown()), item(types.StringUnknown())},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			req := validator.ListRequest{
+				Path:        path.Root("items"),
+				ConfigValue: types.ListValueMust(itemType, tc.items),
+			}
+			resp := &validator.ListResponse{}
+
+			uniqueItemKeys().ValidateList(context.Background(), req, resp)
+
+			if got := resp.Diagnostics.ErrorsCount(); got != tc.wantErrors {
+				t.Errorf("got %d errors, want %d: %v", got, tc.wantErrors, resp.Diagnostics)
+			}
+		})
+	}
+}